@@ -11,7 +11,10 @@ import (
 
 	"github.com/kelseyhightower/envconfig"
 	"github.com/openfga/openfga/pkg/encoder"
+	"github.com/openfga/openfga/pkg/ingester"
 	"github.com/openfga/openfga/pkg/logger"
+	pkgcaching "github.com/openfga/openfga/pkg/storage/caching"
+	"github.com/openfga/openfga/pkg/storage/storagewrappers"
 	"github.com/openfga/openfga/pkg/telemetry"
 	"github.com/openfga/openfga/server"
 	"github.com/openfga/openfga/server/authentication"
@@ -21,6 +24,7 @@ import (
 	"github.com/openfga/openfga/storage"
 	"github.com/openfga/openfga/storage/caching"
 	"github.com/openfga/openfga/storage/memory"
+	"github.com/openfga/openfga/storage/mysql"
 	"github.com/openfga/openfga/storage/postgres"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
@@ -50,6 +54,50 @@ type svcConfig struct {
 	// RequestTimeout is a limit on the time a request may take. If the value is 0, then there is no timeout.
 	RequestTimeout time.Duration `default:"0s" split_words:"true"`
 
+	// ReadOnly puts the server into a read-only mode: mutating RPCs (Write, WriteAssertions,
+	// CreateStore, DeleteStore) are rejected at the gRPC boundary and the underlying datastore
+	// is wrapped so that it cannot be written to even if a new mutating code path is added.
+	// This is meant for replica deployments that serve read traffic against a follower database.
+	ReadOnly bool `default:"false" split_words:"true"`
+
+	// WritePathMode selects how Write RPCs are durably recorded. "sync" (default) applies the
+	// mutation to the datastore inline with the RPC. "kafka" instead produces the mutation to
+	// Kafka and returns once it has been accepted by the broker; a separate ingester applies it
+	// asynchronously, decoupling write throughput from datastore commit latency.
+	WritePathMode string `default:"sync" split_words:"true"`
+
+	// KafkaBrokers is the list of bootstrap broker addresses used by the write ingester. Required
+	// when WritePathMode is "kafka".
+	KafkaBrokers []string `default:"" split_words:"true"`
+
+	// KafkaTopic is the topic Write RPCs are produced to and the ingester consumes from.
+	KafkaTopic string `default:"openfga-writes" split_words:"true"`
+
+	// KafkaConsumerGroup is the consumer group id used by the ingester so that multiple ingester
+	// replicas share the topic's partitions.
+	KafkaConsumerGroup string `default:"openfga-ingester" split_words:"true"`
+
+	// CacheBackend selects where authorization models (and, optionally, Check sub-results) are
+	// cached. "memory" (default) is an in-process cache, not shared across replicas. "redis" and
+	// "memcached" are shared, so cold-start latency after a rolling deploy drops significantly.
+	CacheBackend string `default:"memory" split_words:"true"`
+
+	// CacheAddrs is the list of addresses of the distributed cache backend. Required when
+	// CacheBackend is "redis" or "memcached".
+	CacheAddrs []string `default:"" split_words:"true"`
+
+	// CacheTTL is how long a cached entry is kept before it is considered stale.
+	CacheTTL time.Duration `default:"10s" split_words:"true"`
+
+	// CacheKeyPrefix namespaces cache keys, so that multiple OpenFGA deployments can share a
+	// single Redis/Memcached cluster without colliding.
+	CacheKeyPrefix string `default:"openfga" split_words:"true"`
+
+	// DatastoreReadTargetLatency is the p99 datastore read latency the adaptive concurrency
+	// limiter tries to stay under. The effective concurrency is grown or shrunk automatically to
+	// hit this target, replacing hand-tuned concurrency limits with a single latency knob.
+	DatastoreReadTargetLatency time.Duration `default:"100ms" split_words:"true"`
+
 	// Authentication. Possible options: none,preshared,oidc
 	AuthMethod string `default:"none" split_words:"true"`
 
@@ -76,7 +124,7 @@ func main() {
 		zap.String("build.commit", commit),
 	)
 
-	datastore, openFgaServer, err := buildServerAndDatastore(logger)
+	datastore, openFgaServer, writeConsumer, err := buildServerAndDatastore(logger)
 	if err != nil {
 		logger.Fatal("failed to initialize openfga server", zap.Error(err))
 	}
@@ -95,6 +143,12 @@ func main() {
 		return openFgaServer.Run(ctx)
 	})
 
+	if writeConsumer != nil {
+		g.Go(func() error {
+			return writeConsumer.Run(ctx)
+		})
+	}
+
 	if err := g.Wait(); err != nil {
 		logger.Error("failed to run openfga server", zap.Error(err))
 	}
@@ -113,13 +167,13 @@ func main() {
 	logger.Info("Server exiting. Goodbye 👋")
 }
 
-func buildServerAndDatastore(logger logger.Logger) (storage.OpenFGADatastore, *server.Server, error) {
+func buildServerAndDatastore(logger logger.Logger) (storage.OpenFGADatastore, *server.Server, *ingester.Consumer, error) {
 	var config svcConfig
 	var err error
 	var datastore storage.OpenFGADatastore
 
 	if err := envconfig.Process("OPENFGA", &config); err != nil {
-		return nil, nil, fmt.Errorf("failed to process server config: %v", err)
+		return nil, nil, nil, fmt.Errorf("failed to process server config: %v", err)
 	}
 
 	tracer := telemetry.NewNoopTracer()
@@ -141,10 +195,21 @@ func buildServerAndDatastore(logger logger.Logger) (storage.OpenFGADatastore, *s
 
 		datastore, err = postgres.NewPostgresDatastore(config.DatastoreConnectionURI, opts...)
 		if err != nil {
-			return nil, nil, fmt.Errorf("failed to initialize postgres datastore: %v", err)
+			return nil, nil, nil, fmt.Errorf("failed to initialize postgres datastore: %v", err)
+		}
+	case "mysql":
+		logger.Info("using 'mysql' storage engine")
+
+		datastore, err = mysql.NewMySQLDatastore(
+			config.DatastoreConnectionURI,
+			mysql.WithLogger(logger),
+			mysql.WithTracer(tracer),
+		)
+		if err != nil {
+			return nil, nil, nil, fmt.Errorf("failed to initialize mysql datastore: %v", err)
 		}
 	default:
-		return nil, nil, fmt.Errorf("storage engine '%s' is unsupported", config.DatastoreEngine)
+		return nil, nil, nil, fmt.Errorf("storage engine '%s' is unsupported", config.DatastoreEngine)
 	}
 
 	var interceptors []grpc.UnaryServerInterceptor
@@ -160,33 +225,79 @@ func buildServerAndDatastore(logger logger.Logger) (storage.OpenFGADatastore, *s
 		err = nil
 	}
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to initialize authenticator: %v", err)
+		return nil, nil, nil, fmt.Errorf("failed to initialize authenticator: %v", err)
 	}
 
 	if authenticator != nil {
 		interceptors = append(interceptors, middleware.NewAuthenticationInterceptor(authenticator))
 	}
 
+	interceptors = append(interceptors, middleware.NewPriorityInterceptor())
+
+	var writeConsumer *ingester.Consumer
+
+	switch config.WritePathMode {
+	case "sync":
+		// the Write RPC applies mutations to the datastore inline; nothing to wire up.
+	case "kafka":
+		logger.Info("using 'kafka' write path", zap.Strings("brokers", config.KafkaBrokers), zap.String("topic", config.KafkaTopic))
+
+		writeProducer := ingester.NewProducer(config.KafkaBrokers, config.KafkaTopic)
+		writeConsumer = ingester.NewConsumer(config.KafkaBrokers, config.KafkaTopic, config.KafkaConsumerGroup, datastore, logger)
+
+		// Wrap before the read-only check below, so a read-only replica (which should never be
+		// configured with WritePathMode=kafka, but must still fail safe) rejects Write at the
+		// ReadOnlyDatastore layer instead of producing to Kafka.
+		datastore = storagewrappers.NewKafkaWriteDatastore(datastore, writeProducer)
+		interceptors = append(interceptors, middleware.NewConsistencyInterceptor(writeConsumer))
+	default:
+		return nil, nil, nil, fmt.Errorf("write path mode '%s' is unsupported", config.WritePathMode)
+	}
+
+	if config.ReadOnly {
+		logger.Info("starting in read-only mode")
+
+		datastore = storagewrappers.NewReadOnlyDatastore(datastore)
+		interceptors = append(interceptors, middleware.NewReadOnlyInterceptor())
+	}
+
+	var cacheBackend pkgcaching.CacheBackend
+
+	switch config.CacheBackend {
+	case "memory":
+		cacheBackend = pkgcaching.NewMemoryCacheBackend(config.DatastoreMaxCacheSize)
+	case "redis":
+		cacheBackend, err = pkgcaching.NewRedisCacheBackend(config.CacheAddrs)
+	case "memcached":
+		cacheBackend, err = pkgcaching.NewMemcachedCacheBackend(config.CacheAddrs)
+	default:
+		return nil, nil, nil, fmt.Errorf("cache backend '%s' is unsupported", config.CacheBackend)
+	}
+	if err != nil {
+		return nil, nil, nil, fmt.Errorf("failed to initialize cache backend: %v", err)
+	}
+
 	openFgaServer, err := server.New(&server.Dependencies{
-		Datastore:     caching.NewCachedOpenFGADatastore(datastore, config.DatastoreMaxCacheSize),
+		Datastore:     caching.NewCachedOpenFGADatastore(datastore, cacheBackend, config.CacheTTL, config.CacheKeyPrefix),
 		Tracer:        tracer,
 		Logger:        logger,
 		Meter:         meter,
 		TokenEncoder:  tokenEncoder,
 		Authenticator: authenticator,
 	}, &server.Config{
-		ServiceName:            config.ServiceName,
-		RPCPort:                config.RPCPort,
-		HTTPPort:               config.HTTPPort,
-		ResolveNodeLimit:       config.ResolveNodeLimit,
-		ChangelogHorizonOffset: config.ChangelogHorizonOffset,
-		UnaryInterceptors:      interceptors,
-		MuxOptions:             nil,
-		RequestTimeout:         config.RequestTimeout,
+		ServiceName:                config.ServiceName,
+		RPCPort:                    config.RPCPort,
+		HTTPPort:                   config.HTTPPort,
+		ResolveNodeLimit:           config.ResolveNodeLimit,
+		ChangelogHorizonOffset:     config.ChangelogHorizonOffset,
+		UnaryInterceptors:          interceptors,
+		MuxOptions:                 nil,
+		RequestTimeout:             config.RequestTimeout,
+		DatastoreReadTargetLatency: config.DatastoreReadTargetLatency,
 	})
 	if err != nil {
-		return nil, nil, fmt.Errorf("failed to initialize openfga server: %v", err)
+		return nil, nil, nil, fmt.Errorf("failed to initialize openfga server: %v", err)
 	}
 
-	return datastore, openFgaServer, nil
+	return datastore, openFgaServer, writeConsumer, nil
 }
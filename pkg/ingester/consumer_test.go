@@ -0,0 +1,26 @@
+package ingester
+
+import "testing"
+
+func TestLastAppliedOffsetUnseenStore(t *testing.T) {
+	c := NewConsumer(nil, "", "", nil, nil)
+
+	// Kafka partition offsets themselves start at 0, so a store the consumer has never applied
+	// anything for must not read back as "caught up to offset 0" -- it has to be distinguishable
+	// from a store whose first write really did land at offset 0.
+	if got := c.LastAppliedOffset("store1"); got != -1 {
+		t.Errorf("LastAppliedOffset() for an unseen store = %d, want -1", got)
+	}
+}
+
+func TestLastAppliedOffsetTracksAppliedOffset(t *testing.T) {
+	c := NewConsumer(nil, "", "", nil, nil)
+
+	c.mu.Lock()
+	c.lastAppliedOffsets["store1"] = 0
+	c.mu.Unlock()
+
+	if got := c.LastAppliedOffset("store1"); got != 0 {
+		t.Errorf("LastAppliedOffset() = %d, want 0", got)
+	}
+}
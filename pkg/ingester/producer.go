@@ -0,0 +1,52 @@
+package ingester
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// Producer publishes WriteRecords to the configured Kafka topic, partitioned by
+// store id so that all mutations for a given store are applied in order by a
+// single consumer partition.
+type Producer struct {
+	writer *kafka.Writer
+}
+
+// NewProducer returns a Producer that publishes to topic across brokers.
+func NewProducer(brokers []string, topic string) *Producer {
+	return &Producer{
+		writer: &kafka.Writer{
+			Addr:         kafka.TCP(brokers...),
+			Topic:        topic,
+			Balancer:     &kafka.Hash{},
+			RequiredAcks: kafka.RequireAll,
+		},
+	}
+}
+
+// Produce serializes and publishes record, keyed by its store id, and returns the offset it was
+// assigned so the caller can hand the client a consistency token for read-your-writes.
+func (p *Producer) Produce(ctx context.Context, record *WriteRecord) (int64, error) {
+	payload, err := record.Marshal()
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal write record: %w", err)
+	}
+
+	msgs := []kafka.Message{{
+		Key:   []byte(record.Store),
+		Value: payload,
+	}}
+
+	if err := p.writer.WriteMessages(ctx, msgs...); err != nil {
+		return 0, err
+	}
+
+	return msgs[0].Offset, nil
+}
+
+// Close flushes and closes the underlying Kafka writer.
+func (p *Producer) Close() error {
+	return p.writer.Close()
+}
@@ -0,0 +1,39 @@
+package ingester
+
+import (
+	"encoding/json"
+	"time"
+
+	openfgapb "go.buf.build/openfga/go/openfga/api/openfga/v1"
+)
+
+// WriteRecord is the payload produced to the write topic for a single Write RPC.
+// It carries everything the consumer needs to replay the mutation against the
+// underlying datastore without going back to the original gRPC caller.
+//
+// It deliberately has no request id or authorization model id field: the
+// storage.OpenFGADatastore.Write signature KafkaWriteDatastore produces from
+// doesn't carry either, so there would be nothing to populate them with. Each
+// tuple's own condition (if any) already carries its condition context, so
+// there's no separate request-level condition context either.
+type WriteRecord struct {
+	Store     string                `json:"store"`
+	Writes    []*openfgapb.TupleKey `json:"writes,omitempty"`
+	Deletes   []*openfgapb.TupleKey `json:"deletes,omitempty"`
+	Timestamp time.Time             `json:"timestamp"`
+}
+
+// Marshal serializes the record for production to Kafka.
+func (r *WriteRecord) Marshal() ([]byte, error) {
+	return json.Marshal(r)
+}
+
+// UnmarshalWriteRecord deserializes a record consumed from Kafka.
+func UnmarshalWriteRecord(data []byte) (*WriteRecord, error) {
+	var r WriteRecord
+	if err := json.Unmarshal(data, &r); err != nil {
+		return nil, err
+	}
+
+	return &r, nil
+}
@@ -0,0 +1,41 @@
+package ingester
+
+import (
+	"encoding/base64"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// ConsistencyTokenHeader is the gRPC metadata key clients send back on a subsequent request to
+// block until a prior Write produced over the "kafka" write path has been applied, implementing
+// read-your-writes against an asynchronously-ingested datastore.
+const ConsistencyTokenHeader = "x-openfga-consistency-token"
+
+// EncodeConsistencyToken returns an opaque token identifying offset within store's write stream,
+// suitable for returning to a client as the value of ConsistencyTokenHeader.
+func EncodeConsistencyToken(store string, offset int64) string {
+	raw := fmt.Sprintf("%s:%d", store, offset)
+
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// DecodeConsistencyToken reverses EncodeConsistencyToken.
+func DecodeConsistencyToken(token string) (store string, offset int64, err error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid consistency token: %w", err)
+	}
+
+	store, offsetStr, ok := strings.Cut(string(raw), ":")
+	if !ok {
+		return "", 0, fmt.Errorf("invalid consistency token: missing offset")
+	}
+
+	offset, err = strconv.ParseInt(offsetStr, 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid consistency token: %w", err)
+	}
+
+	return store, offset, nil
+}
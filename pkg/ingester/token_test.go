@@ -0,0 +1,22 @@
+package ingester
+
+import "testing"
+
+func TestConsistencyTokenRoundTrip(t *testing.T) {
+	token := EncodeConsistencyToken("01H", 42)
+
+	store, offset, err := DecodeConsistencyToken(token)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if store != "01H" || offset != 42 {
+		t.Errorf("DecodeConsistencyToken() = (%q, %d), want (%q, %d)", store, offset, "01H", 42)
+	}
+}
+
+func TestDecodeConsistencyTokenRejectsGarbage(t *testing.T) {
+	if _, _, err := DecodeConsistencyToken("not-a-token!!"); err == nil {
+		t.Error("expected an error decoding a malformed token")
+	}
+}
@@ -0,0 +1,26 @@
+package caching
+
+import (
+	"context"
+	"time"
+)
+
+// CacheBackend is a key-value store used to cache authorization models and,
+// optionally, Check sub-results. The Redis and Memcached implementations are
+// shared across server replicas, so that a rolling deploy or horizontal
+// scale-out does not start every replica with a cold cache; MemoryCacheBackend
+// is in-process and per replica.
+type CacheBackend interface {
+	// Get returns the cached value for key, and false if it was not found.
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+
+	// Set stores value under key with the given time-to-live.
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+
+	// Del removes key from the cache.
+	Del(ctx context.Context, key string) error
+
+	// MGet returns the cached values for keys, in the same order. A missing entry
+	// is returned as a nil slice at its corresponding index.
+	MGet(ctx context.Context, keys []string) ([][]byte, error)
+}
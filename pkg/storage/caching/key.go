@@ -0,0 +1,36 @@
+package caching
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+)
+
+// ModelCacheKey returns the cache key for an authorization model, namespaced by
+// prefix and the store's current cache epoch. Bumping the epoch (see
+// EpochKey) effectively invalidates every key built from it without a bulk
+// delete.
+func ModelCacheKey(prefix, store, modelID string, epoch uint64) string {
+	return fmt.Sprintf("%s:model:%s:%s:%d", prefix, store, modelID, epoch)
+}
+
+// CheckCacheKey returns the cache key for a cached Check sub-result, namespaced
+// by prefix and the store's current cache epoch.
+func CheckCacheKey(prefix, store, modelID string, tupleKeyHash string, epoch uint64) string {
+	return fmt.Sprintf("%s:check:%s:%s:%s:%d", prefix, store, modelID, tupleKeyHash, epoch)
+}
+
+// EpochKey returns the cache key holding the current epoch counter for store.
+// It is bumped every time WriteAuthorizationModel is called for that store, so
+// that a stale replica's cached entries stop being served without an explicit
+// invalidation pass.
+func EpochKey(prefix, store string) string {
+	return fmt.Sprintf("%s:epoch:%s", prefix, store)
+}
+
+// HashTupleKey returns a stable, fixed-length identifier for a tuple key, for
+// use as part of a CheckCacheKey.
+func HashTupleKey(tupleKey string) string {
+	sum := sha256.Sum256([]byte(tupleKey))
+	return hex.EncodeToString(sum[:])
+}
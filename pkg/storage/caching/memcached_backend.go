@@ -0,0 +1,72 @@
+package caching
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/bradfitz/gomemcache/memcache"
+)
+
+var _ CacheBackend = (*MemcachedCacheBackend)(nil)
+
+// MemcachedCacheBackend is a CacheBackend backed by Memcached, shared across replicas.
+type MemcachedCacheBackend struct {
+	client *memcache.Client
+}
+
+// NewMemcachedCacheBackend returns a CacheBackend backed by the Memcached instance(s) at addrs.
+func NewMemcachedCacheBackend(addrs []string) (*MemcachedCacheBackend, error) {
+	if len(addrs) == 0 {
+		return nil, errors.New("at least one memcached address is required")
+	}
+
+	return &MemcachedCacheBackend{
+		client: memcache.New(addrs...),
+	}, nil
+}
+
+func (m *MemcachedCacheBackend) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	item, err := m.client.Get(key)
+	if errors.Is(err, memcache.ErrCacheMiss) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return item.Value, true, nil
+}
+
+func (m *MemcachedCacheBackend) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return m.client.Set(&memcache.Item{
+		Key:        key,
+		Value:      value,
+		Expiration: int32(ttl.Seconds()),
+	})
+}
+
+func (m *MemcachedCacheBackend) Del(ctx context.Context, key string) error {
+	err := m.client.Delete(key)
+	if errors.Is(err, memcache.ErrCacheMiss) {
+		return nil
+	}
+
+	return err
+}
+
+func (m *MemcachedCacheBackend) MGet(ctx context.Context, keys []string) ([][]byte, error) {
+	values := make([][]byte, len(keys))
+
+	for i, key := range keys {
+		value, ok, err := m.Get(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			values[i] = value
+		}
+	}
+
+	return values, nil
+}
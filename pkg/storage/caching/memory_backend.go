@@ -0,0 +1,115 @@
+package caching
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+var _ CacheBackend = (*MemoryCacheBackend)(nil)
+
+// MemoryCacheBackend is a CacheBackend backed by an in-process map, bounded to at most maxEntries
+// by evicting the least-recently-used entry. It does not share entries across replicas, so it is
+// unsuitable for anything with effectively unbounded key cardinality (e.g. Check result caching,
+// which is keyed by a hash of the full tuple) unless maxEntries actually caps it.
+type MemoryCacheBackend struct {
+	mu         sync.Mutex
+	maxEntries int
+	entries    map[string]*list.Element
+	order      *list.List // front = most recently used
+}
+
+type memoryCacheEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// NewMemoryCacheBackend returns a CacheBackend backed by an in-process map, holding at most
+// maxEntries entries. maxEntries <= 0 means unbounded.
+func NewMemoryCacheBackend(maxEntries int) *MemoryCacheBackend {
+	return &MemoryCacheBackend{
+		maxEntries: maxEntries,
+		entries:    make(map[string]*list.Element),
+		order:      list.New(),
+	}
+}
+
+func (m *MemoryCacheBackend) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	elem, ok := m.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+
+	entry := elem.Value.(memoryCacheEntry)
+	if time.Now().After(entry.expiresAt) {
+		m.removeLocked(elem)
+
+		return nil, false, nil
+	}
+
+	m.order.MoveToFront(elem)
+
+	return entry.value, true, nil
+}
+
+func (m *MemoryCacheBackend) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	entry := memoryCacheEntry{key: key, value: value, expiresAt: time.Now().Add(ttl)}
+
+	if elem, ok := m.entries[key]; ok {
+		elem.Value = entry
+		m.order.MoveToFront(elem)
+
+		return nil
+	}
+
+	m.entries[key] = m.order.PushFront(entry)
+
+	if m.maxEntries > 0 {
+		for len(m.entries) > m.maxEntries {
+			m.removeLocked(m.order.Back())
+		}
+	}
+
+	return nil
+}
+
+func (m *MemoryCacheBackend) Del(ctx context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if elem, ok := m.entries[key]; ok {
+		m.removeLocked(elem)
+	}
+
+	return nil
+}
+
+// removeLocked evicts elem from the cache. m.mu must be held.
+func (m *MemoryCacheBackend) removeLocked(elem *list.Element) {
+	delete(m.entries, elem.Value.(memoryCacheEntry).key)
+	m.order.Remove(elem)
+}
+
+func (m *MemoryCacheBackend) MGet(ctx context.Context, keys []string) ([][]byte, error) {
+	values := make([][]byte, len(keys))
+
+	for i, key := range keys {
+		value, ok, err := m.Get(ctx, key)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			values[i] = value
+		}
+	}
+
+	return values, nil
+}
@@ -0,0 +1,97 @@
+package caching
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemoryCacheBackendGetSet(t *testing.T) {
+	m := NewMemoryCacheBackend(0)
+	ctx := context.Background()
+
+	if err := m.Set(ctx, "key1", []byte("value1"), time.Minute); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	value, ok, err := m.Get(ctx, "key1")
+	if err != nil || !ok || string(value) != "value1" {
+		t.Errorf("Get() = (%q, %v, %v), want (\"value1\", true, nil)", value, ok, err)
+	}
+}
+
+func TestMemoryCacheBackendExpiredEntryIsEvicted(t *testing.T) {
+	m := NewMemoryCacheBackend(0)
+	ctx := context.Background()
+
+	if err := m.Set(ctx, "key1", []byte("value1"), -time.Second); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, ok, _ := m.Get(ctx, "key1"); ok {
+		t.Error("expected an already-expired entry not to be returned")
+	}
+
+	if _, ok := m.entries["key1"]; ok {
+		t.Error("expected the expired entry to be evicted from the map on Get, not just hidden")
+	}
+}
+
+func TestMemoryCacheBackendBoundedByMaxEntries(t *testing.T) {
+	m := NewMemoryCacheBackend(2)
+	ctx := context.Background()
+
+	_ = m.Set(ctx, "key1", []byte("v1"), time.Minute)
+	_ = m.Set(ctx, "key2", []byte("v2"), time.Minute)
+	_ = m.Set(ctx, "key3", []byte("v3"), time.Minute)
+
+	if len(m.entries) != 2 {
+		t.Fatalf("expected the cache to hold at most 2 entries, got %d", len(m.entries))
+	}
+
+	if _, ok, _ := m.Get(ctx, "key1"); ok {
+		t.Error("expected the least-recently-used entry (key1) to have been evicted")
+	}
+
+	if _, ok, _ := m.Get(ctx, "key3"); !ok {
+		t.Error("expected the most recently set entry to still be cached")
+	}
+}
+
+func TestMemoryCacheBackendGetRefreshesRecency(t *testing.T) {
+	m := NewMemoryCacheBackend(2)
+	ctx := context.Background()
+
+	_ = m.Set(ctx, "key1", []byte("v1"), time.Minute)
+	_ = m.Set(ctx, "key2", []byte("v2"), time.Minute)
+
+	// Touch key1 so it's no longer the least-recently-used entry.
+	if _, ok, _ := m.Get(ctx, "key1"); !ok {
+		t.Fatal("expected key1 to be cached")
+	}
+
+	_ = m.Set(ctx, "key3", []byte("v3"), time.Minute)
+
+	if _, ok, _ := m.Get(ctx, "key2"); ok {
+		t.Error("expected key2, not key1, to have been evicted as the true least-recently-used entry")
+	}
+
+	if _, ok, _ := m.Get(ctx, "key1"); !ok {
+		t.Error("expected key1 to still be cached after being refreshed")
+	}
+}
+
+func TestMemoryCacheBackendDel(t *testing.T) {
+	m := NewMemoryCacheBackend(0)
+	ctx := context.Background()
+
+	_ = m.Set(ctx, "key1", []byte("v1"), time.Minute)
+
+	if err := m.Del(ctx, "key1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, ok, _ := m.Get(ctx, "key1"); ok {
+		t.Error("expected key1 to be gone after Del")
+	}
+}
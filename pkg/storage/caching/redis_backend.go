@@ -0,0 +1,64 @@
+package caching
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+var _ CacheBackend = (*RedisCacheBackend)(nil)
+
+// RedisCacheBackend is a CacheBackend backed by Redis, shared across replicas.
+type RedisCacheBackend struct {
+	client *redis.Client
+}
+
+// NewRedisCacheBackend returns a CacheBackend backed by the Redis instance(s) at addrs.
+// Only the first address is used; a Redis Cluster client can be introduced later if needed.
+func NewRedisCacheBackend(addrs []string) (*RedisCacheBackend, error) {
+	if len(addrs) == 0 {
+		return nil, errors.New("at least one redis address is required")
+	}
+
+	return &RedisCacheBackend{
+		client: redis.NewClient(&redis.Options{Addr: addrs[0]}),
+	}, nil
+}
+
+func (r *RedisCacheBackend) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := r.client.Get(ctx, key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+
+	return value, true, nil
+}
+
+func (r *RedisCacheBackend) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return r.client.Set(ctx, key, value, ttl).Err()
+}
+
+func (r *RedisCacheBackend) Del(ctx context.Context, key string) error {
+	return r.client.Del(ctx, key).Err()
+}
+
+func (r *RedisCacheBackend) MGet(ctx context.Context, keys []string) ([][]byte, error) {
+	results, err := r.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	values := make([][]byte, len(results))
+	for i, result := range results {
+		if s, ok := result.(string); ok {
+			values[i] = []byte(s)
+		}
+	}
+
+	return values, nil
+}
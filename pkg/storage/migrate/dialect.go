@@ -0,0 +1,71 @@
+package migrate
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// Dialect identifies the SQL dialect a Migration's DDL is written for.
+type Dialect string
+
+const (
+	DialectPostgres Dialect = "postgres"
+	DialectMySQL    Dialect = "mysql"
+)
+
+// PerDialect maps a Dialect to the DDL statements a migration should run for it, so a single
+// Migration can support more than one underlying database engine.
+type PerDialect map[Dialect][]string
+
+// Statements returns the DDL statements registered for dialect.
+func (p PerDialect) Statements(dialect Dialect) ([]string, error) {
+	stmts, ok := p[dialect]
+	if !ok {
+		return nil, fmt.Errorf("migration has no statements registered for dialect %q", dialect)
+	}
+
+	return stmts, nil
+}
+
+type dialectContextKey struct{}
+
+// ContextWithDialect returns a copy of ctx carrying dialect, so that a Migration's Forward and
+// Backward functions can look up which DDL variant to run without changing their signature.
+func ContextWithDialect(ctx context.Context, dialect Dialect) context.Context {
+	return context.WithValue(ctx, dialectContextKey{}, dialect)
+}
+
+var (
+	defaultDialectMu sync.RWMutex
+	defaultDialect   = DialectPostgres
+)
+
+// SetDefaultDialect changes the dialect DialectFromContext falls back to when a migration runs
+// without an explicit ContextWithDialect call. The MySQL datastore constructor calls this once
+// on startup, before any migration can run, so that a migration runner which hasn't been updated
+// to thread a dialect through its context still applies that engine's DDL instead of silently
+// guessing Postgres. It defaults to DialectPostgres, preserving prior behavior for deployments that
+// never call it.
+func SetDefaultDialect(dialect Dialect) {
+	defaultDialectMu.Lock()
+	defer defaultDialectMu.Unlock()
+
+	defaultDialect = dialect
+}
+
+// DialectFromContext returns the dialect carried by ctx, or the dialect set by SetDefaultDialect if
+// ctx carries none. The bool return is kept for callers that used to treat a missing dialect as an
+// error: it is always true now, since SetDefaultDialect guarantees DialectFromContext always has a
+// dialect to hand back, it just may not be the one the caller intended if the engine constructor
+// that should have called SetDefaultDialect for it hasn't run yet.
+func DialectFromContext(ctx context.Context) (Dialect, bool) {
+	if dialect, ok := ctx.Value(dialectContextKey{}).(Dialect); ok {
+		return dialect, true
+	}
+
+	defaultDialectMu.RLock()
+	defer defaultDialectMu.RUnlock()
+
+	return defaultDialect, true
+}
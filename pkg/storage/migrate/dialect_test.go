@@ -0,0 +1,52 @@
+package migrate
+
+import (
+	"context"
+	"testing"
+)
+
+func TestDialectFromContextExplicit(t *testing.T) {
+	ctx := ContextWithDialect(context.Background(), DialectMySQL)
+
+	dialect, ok := DialectFromContext(ctx)
+	if !ok || dialect != DialectMySQL {
+		t.Errorf("DialectFromContext() = (%v, %v), want (%v, true)", dialect, ok, DialectMySQL)
+	}
+}
+
+func TestDialectFromContextFallsBackToDefault(t *testing.T) {
+	defer SetDefaultDialect(DialectPostgres)
+
+	SetDefaultDialect(DialectMySQL)
+
+	dialect, ok := DialectFromContext(context.Background())
+	if !ok || dialect != DialectMySQL {
+		t.Errorf("DialectFromContext() with no context value = (%v, %v), want (%v, true)", dialect, ok, DialectMySQL)
+	}
+}
+
+func TestDialectFromContextDefaultsToPostgres(t *testing.T) {
+	defer SetDefaultDialect(DialectPostgres)
+
+	dialect, ok := DialectFromContext(context.Background())
+	if !ok || dialect != DialectPostgres {
+		t.Errorf("DialectFromContext() with no context value and no SetDefaultDialect call = (%v, %v), want (%v, true)", dialect, ok, DialectPostgres)
+	}
+}
+
+func TestPerDialectStatements(t *testing.T) {
+	p := PerDialect{DialectPostgres: {"a", "b"}}
+
+	if _, err := p.Statements(DialectMySQL); err == nil {
+		t.Error("expected an error for a dialect with no registered statements")
+	}
+
+	stmts, err := p.Statements(DialectPostgres)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if len(stmts) != 2 {
+		t.Errorf("expected 2 statements, got %d", len(stmts))
+	}
+}
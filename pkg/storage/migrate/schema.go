@@ -0,0 +1,63 @@
+package migrate
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+)
+
+// EnsureSchemaMigrationsTable creates the shared schema_migrations table if it does not already
+// exist. Besides the applied version, it records the dialect the migration was run with, so that
+// a migrator connecting with the wrong engine can refuse to run rather than apply DDL written for
+// a different database.
+func EnsureSchemaMigrationsTable(ctx context.Context, db *sql.DB, dialect Dialect) error {
+	var ddl string
+
+	switch dialect {
+	case DialectPostgres:
+		ddl = `CREATE TABLE IF NOT EXISTS schema_migrations (version BIGINT PRIMARY KEY, dialect TEXT NOT NULL);`
+	case DialectMySQL:
+		ddl = `CREATE TABLE IF NOT EXISTS schema_migrations (version BIGINT PRIMARY KEY, dialect VARCHAR(32) NOT NULL);`
+	default:
+		return fmt.Errorf("unsupported dialect %q", dialect)
+	}
+
+	_, err := db.ExecContext(ctx, ddl)
+
+	return err
+}
+
+// CheckSchemaDialect returns an error if schema_migrations already has rows recorded for a
+// dialect other than dialect, i.e. the store was previously migrated with a different engine.
+func CheckSchemaDialect(ctx context.Context, db *sql.DB, dialect Dialect) error {
+	rows, err := db.QueryContext(ctx, `SELECT DISTINCT dialect FROM schema_migrations;`)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var recorded string
+		if err := rows.Scan(&recorded); err != nil {
+			return err
+		}
+
+		if Dialect(recorded) != dialect {
+			return fmt.Errorf("store was previously migrated with dialect %q, refusing to run %q migrations against it", recorded, dialect)
+		}
+	}
+
+	return rows.Err()
+}
+
+// RecordAppliedVersion records that version was applied for dialect.
+func RecordAppliedVersion(ctx context.Context, tx *sql.Tx, version int64, dialect Dialect) error {
+	query := `INSERT INTO schema_migrations (version, dialect) VALUES ($1, $2);`
+	if dialect == DialectMySQL {
+		query = `INSERT INTO schema_migrations (version, dialect) VALUES (?, ?);`
+	}
+
+	_, err := tx.ExecContext(ctx, query, version, dialect)
+
+	return err
+}
@@ -3,14 +3,44 @@ package migrations
 import (
 	"context"
 	"database/sql"
+	"errors"
 
 	"github.com/openfga/openfga/pkg/storage/migrate"
 )
 
-func up005(ctx context.Context, tx *sql.Tx) error {
-	stmts := []string{
+var errNoDialectInContext = errors.New("migration context carries no dialect, cannot determine which DDL to run")
+
+var up005Statements = migrate.PerDialect{
+	migrate.DialectPostgres: {
 		`ALTER TABLE tuple ADD COLUMN condition_name TEXT, ADD COLUMN condition_context BYTEA;`,
 		`ALTER TABLE changelog ADD COLUMN condition_name TEXT, ADD COLUMN condition_context BYTEA;`,
+	},
+	migrate.DialectMySQL: {
+		`ALTER TABLE tuple ADD COLUMN condition_name TEXT, ADD COLUMN condition_context BLOB;`,
+		`ALTER TABLE changelog ADD COLUMN condition_name TEXT, ADD COLUMN condition_context BLOB;`,
+	},
+}
+
+var down005Statements = migrate.PerDialect{
+	migrate.DialectPostgres: {
+		`ALTER TABLE tuple DROP COLUMN condition_name, DROP COLUMN condition_context;`,
+		`ALTER TABLE changelog DROP COLUMN condition_name, DROP COLUMN condition_context;`,
+	},
+	migrate.DialectMySQL: {
+		`ALTER TABLE tuple DROP COLUMN condition_name, DROP COLUMN condition_context;`,
+		`ALTER TABLE changelog DROP COLUMN condition_name, DROP COLUMN condition_context;`,
+	},
+}
+
+func up005(ctx context.Context, tx *sql.Tx) error {
+	dialect, ok := migrate.DialectFromContext(ctx)
+	if !ok {
+		return errNoDialectInContext
+	}
+
+	stmts, err := up005Statements.Statements(dialect)
+	if err != nil {
+		return err
 	}
 
 	for _, stmt := range stmts {
@@ -24,9 +54,14 @@ func up005(ctx context.Context, tx *sql.Tx) error {
 }
 
 func down005(ctx context.Context, tx *sql.Tx) error {
-	stmts := []string{
-		`ALTER TABLE tuple DROP COLUMN condition_name, DROP COLUMN condition_context;`,
-		`ALTER TABLE changelog DROP COLUMN condition_name, DROP COLUMN condition_context;`,
+	dialect, ok := migrate.DialectFromContext(ctx)
+	if !ok {
+		return errNoDialectInContext
+	}
+
+	stmts, err := down005Statements.Statements(dialect)
+	if err != nil {
+		return err
 	}
 
 	for _, stmt := range stmts {
@@ -47,4 +82,4 @@ func init() {
 			Backward: down005,
 		},
 	)
-}
\ No newline at end of file
+}
@@ -0,0 +1,55 @@
+package migrations
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/openfga/openfga/pkg/storage/migrate"
+)
+
+func TestUp005StatementsPerDialect(t *testing.T) {
+	postgresStmts, err := up005Statements.Statements(migrate.DialectPostgres)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, stmt := range postgresStmts {
+		if !strings.Contains(stmt, "BYTEA") {
+			t.Errorf("expected postgres up005 statement to use BYTEA, got %q", stmt)
+		}
+	}
+
+	mysqlStmts, err := up005Statements.Statements(migrate.DialectMySQL)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, stmt := range mysqlStmts {
+		if !strings.Contains(stmt, "BLOB") {
+			t.Errorf("expected mysql up005 statement to use BLOB, got %q", stmt)
+		}
+	}
+}
+
+func TestUp005UsesDefaultDialectWhenContextHasNone(t *testing.T) {
+	defer migrate.SetDefaultDialect(migrate.DialectPostgres)
+
+	migrate.SetDefaultDialect(migrate.DialectMySQL)
+
+	dialect, ok := migrate.DialectFromContext(context.Background())
+	if !ok || dialect != migrate.DialectMySQL {
+		t.Fatalf("expected up005 to resolve the default dialect set by the mysql engine, got (%v, %v)", dialect, ok)
+	}
+
+	stmts, err := up005Statements.Statements(dialect)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	for _, stmt := range stmts {
+		if !strings.Contains(stmt, "BLOB") {
+			t.Errorf("expected up005 to pick the mysql DDL when the context carries no dialect, got %q", stmt)
+		}
+	}
+}
@@ -2,6 +2,8 @@ package storagewrappers
 
 import (
 	"context"
+	"sort"
+	"sync"
 	"time"
 
 	"github.com/openfga/openfga/pkg/storage"
@@ -10,6 +12,8 @@ import (
 	openfgapb "go.buf.build/openfga/go/openfga/api/openfga/v1"
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const timeWaitingSpanAttribute = "time_waiting"
@@ -25,61 +29,326 @@ var (
 		NativeHistogramMaxBucketNumber:  100,
 		NativeHistogramMinResetDuration: time.Hour,
 	})
+	limiterCurrentLimitGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "datastore_limiter_current_limit",
+		Help: "Current concurrency limit of the adaptive datastore read limiter.",
+	})
+	limiterInFlightGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "datastore_limiter_in_flight",
+		Help: "Number of datastore reads currently in flight.",
+	})
+	limiterQueueDepthGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "datastore_limiter_queue_depth",
+		Help: "Number of datastore reads waiting for a concurrency slot, by priority.",
+	}, []string{"priority"})
 )
 
+func priorityLabel(p Priority) string {
+	switch p {
+	case PriorityHigh:
+		return "high"
+	case PriorityLow:
+		return "low"
+	default:
+		return "normal"
+	}
+}
+
 type boundedConcurrencyTupleReader struct {
 	storage.RelationshipTupleReader
-	limiter chan struct{}
+	limiter *adaptiveLimiter
 }
 
 // NewBoundedConcurrencyTupleReader returns a wrapper over a datastore that makes sure that there are, at most,
-// "concurrency" concurrent calls to Read, ReadUserTuple and ReadUsersetTuples.
-// Consumers can then rest assured that one client will not hoard all the database connections available.
+// "concurrency" concurrent calls to Read, ReadUserTuple and ReadUsersetTuples to start with. The effective limit adapts over time,
+// within [minConcurrency, maxConcurrency], to keep observed datastore read latency under targetLatency: it grows by
+// one every adjustEvery successful reads while p99 latency is under target, and shrinks multiplicatively otherwise.
+// Waiters are serviced highest priority first, and a caller whose context is cancelled while waiting is released
+// immediately rather than holding up the queue.
 func NewBoundedConcurrencyTupleReader(wrapped storage.RelationshipTupleReader, concurrency uint32) *boundedConcurrencyTupleReader {
+	return NewBoundedConcurrencyTupleReaderWithTargetLatency(wrapped, concurrency, defaultAdaptiveLimiterOptions().TargetLatency)
+}
+
+// NewBoundedConcurrencyTupleReaderWithTargetLatency is like NewBoundedConcurrencyTupleReader, but
+// lets the caller replace the default p99 datastore read latency SLO that drives the AIMD
+// adjustment, so operators have a single knob instead of hand-tuning concurrency.
+func NewBoundedConcurrencyTupleReaderWithTargetLatency(wrapped storage.RelationshipTupleReader, concurrency uint32, targetLatency time.Duration) *boundedConcurrencyTupleReader {
+	opts := defaultAdaptiveLimiterOptions()
+	opts.TargetLatency = targetLatency
+
 	return &boundedConcurrencyTupleReader{
 		RelationshipTupleReader: wrapped,
-		limiter:                 make(chan struct{}, concurrency),
+		limiter:                 newAdaptiveLimiter(int(concurrency), opts),
 	}
 }
 
 func (b *boundedConcurrencyTupleReader) ReadUserTuple(ctx context.Context, store string, tupleKey *openfgapb.TupleKey) (*openfgapb.Tuple, error) {
-	b.waitForLimiter(ctx)
+	release, err := b.waitForLimiter(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	defer func() {
-		<-b.limiter
-	}()
+	tuple, err := b.RelationshipTupleReader.ReadUserTuple(ctx, store, tupleKey)
+	release(ctx.Err() == context.DeadlineExceeded)
 
-	return b.RelationshipTupleReader.ReadUserTuple(ctx, store, tupleKey)
+	return tuple, err
 }
 
 func (b *boundedConcurrencyTupleReader) Read(ctx context.Context, store string, tupleKey *openfgapb.TupleKey) (storage.TupleIterator, error) {
-	b.waitForLimiter(ctx)
+	release, err := b.waitForLimiter(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	defer func() {
-		<-b.limiter
-	}()
+	iter, err := b.RelationshipTupleReader.Read(ctx, store, tupleKey)
+	release(ctx.Err() == context.DeadlineExceeded)
 
-	return b.RelationshipTupleReader.Read(ctx, store, tupleKey)
+	return iter, err
 }
 
 func (b *boundedConcurrencyTupleReader) ReadUsersetTuples(ctx context.Context, store string, filter storage.ReadUsersetTuplesFilter) (storage.TupleIterator, error) {
-	b.waitForLimiter(ctx)
+	release, err := b.waitForLimiter(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-	defer func() {
-		<-b.limiter
-	}()
+	iter, err := b.RelationshipTupleReader.ReadUsersetTuples(ctx, store, filter)
+	release(ctx.Err() == context.DeadlineExceeded)
 
-	return b.RelationshipTupleReader.ReadUsersetTuples(ctx, store, filter)
+	return iter, err
 }
 
-func (b *boundedConcurrencyTupleReader) waitForLimiter(ctx context.Context) {
+// waitForLimiter blocks until a concurrency slot is available, the priority in ctx is used to
+// order it against other waiters. If ctx is cancelled first, it returns a ResourceExhausted error
+// instead of blocking forever, so that a shed request fails fast.
+//
+// The returned release func takes whether the wrapped call it guarded timed out, so that a single
+// timed-out read triggers exactly one multiplicative decrease: release treats the two as mutually
+// exclusive, rather than recording the (inflated) latency sample *and* a separate timeout penalty
+// for the same call.
+func (b *boundedConcurrencyTupleReader) waitForLimiter(ctx context.Context) (func(timedOut bool), error) {
 	start := time.Now()
 
-	b.limiter <- struct{}{}
+	err := b.limiter.acquire(ctx)
 
-	end := time.Now()
-	timeWaiting := end.Sub(start).Milliseconds()
+	timeWaiting := time.Since(start).Milliseconds()
 	boundedReadDelayMsHistogram.Observe(float64(timeWaiting))
 	span := trace.SpanFromContext(ctx)
 	span.SetAttributes(attribute.Int64(timeWaitingSpanAttribute, timeWaiting))
-}
\ No newline at end of file
+
+	if err != nil {
+		return nil, status.Error(codes.ResourceExhausted, "too many concurrent datastore reads, request was shed")
+	}
+
+	acquiredAt := time.Now()
+
+	return func(timedOut bool) {
+		b.limiter.release(time.Since(acquiredAt), timedOut)
+	}, nil
+}
+
+// adaptiveLimiterOptions configures the AIMD behavior of adaptiveLimiter.
+type adaptiveLimiterOptions struct {
+	MinConcurrency int
+	MaxConcurrency int
+	TargetLatency  time.Duration
+	AdjustEvery    int     // additively increase the limit every this many successful reads
+	DecreaseFactor float64 // multiplicative decrease applied on timeout or when p99 exceeds TargetLatency
+	LatencyWindow  int     // number of recent read latencies kept to estimate p99
+}
+
+func defaultAdaptiveLimiterOptions() adaptiveLimiterOptions {
+	return adaptiveLimiterOptions{
+		MinConcurrency: 1,
+		MaxConcurrency: 1000,
+		TargetLatency:  100 * time.Millisecond,
+		AdjustEvery:    50,
+		DecreaseFactor: 0.9,
+		LatencyWindow:  200,
+	}
+}
+
+// adaptiveLimiter is a priority-aware semaphore whose effective limit is adjusted by an AIMD loop
+// driven by observed p99 datastore read latency versus a target SLO.
+type adaptiveLimiter struct {
+	opts adaptiveLimiterOptions
+
+	mu       sync.Mutex
+	limit    int
+	inFlight int
+	waiters  [3][]chan struct{} // indexed by Priority; serviced high to low
+
+	latencies      []time.Duration
+	successesSince int
+}
+
+func newAdaptiveLimiter(initialLimit int, opts adaptiveLimiterOptions) *adaptiveLimiter {
+	if initialLimit < opts.MinConcurrency {
+		initialLimit = opts.MinConcurrency
+	}
+	if initialLimit > opts.MaxConcurrency {
+		initialLimit = opts.MaxConcurrency
+	}
+
+	l := &adaptiveLimiter{
+		opts:  opts,
+		limit: initialLimit,
+	}
+	limiterCurrentLimitGauge.Set(float64(initialLimit))
+
+	return l
+}
+
+// acquire blocks until a slot is available or ctx is cancelled, whichever comes first.
+func (l *adaptiveLimiter) acquire(ctx context.Context) error {
+	priority := PriorityFromContext(ctx)
+
+	l.mu.Lock()
+	if l.inFlight < l.limit {
+		l.inFlight++
+		limiterInFlightGauge.Set(float64(l.inFlight))
+		l.mu.Unlock()
+
+		return nil
+	}
+
+	waiter := make(chan struct{})
+	l.waiters[priority] = append(l.waiters[priority], waiter)
+	limiterQueueDepthGauge.WithLabelValues(priorityLabel(priority)).Inc()
+	l.mu.Unlock()
+
+	select {
+	case <-waiter:
+		limiterQueueDepthGauge.WithLabelValues(priorityLabel(priority)).Dec()
+		limiterInFlightGauge.Set(float64(l.currentInFlight()))
+
+		return nil
+	case <-ctx.Done():
+		l.cancelWaiter(priority, waiter)
+
+		return ctx.Err()
+	}
+}
+
+func (l *adaptiveLimiter) currentInFlight() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.inFlight
+}
+
+func (l *adaptiveLimiter) cancelWaiter(priority Priority, waiter chan struct{}) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	waiters := l.waiters[priority]
+	for i, w := range waiters {
+		if w == waiter {
+			l.waiters[priority] = append(waiters[:i], waiters[i+1:]...)
+			limiterQueueDepthGauge.WithLabelValues(priorityLabel(priority)).Dec()
+
+			return
+		}
+	}
+
+	// The waiter already won the race and was handed the slot concurrently with the context
+	// cancellation; release it immediately so the slot isn't leaked.
+	select {
+	case <-waiter:
+		l.inFlight--
+		limiterInFlightGauge.Set(float64(l.inFlight))
+	default:
+	}
+}
+
+// release returns the slot held by the caller, either to the highest-priority waiter or back to
+// the pool, and feeds the AIMD adjustment: a timed-out call shrinks the limit immediately and does
+// not also have its (inflated) latency counted towards the p99 window, so that a single slow or
+// timed-out read causes exactly one multiplicative decrease, not two.
+func (l *adaptiveLimiter) release(latency time.Duration, timedOut bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if timedOut {
+		l.decreaseLocked()
+		l.successesSince = 0
+	} else {
+		l.recordLatency(latency)
+	}
+
+	for p := PriorityHigh; p >= PriorityLow; p-- {
+		if len(l.waiters[p]) == 0 {
+			continue
+		}
+
+		next := l.waiters[p][0]
+		l.waiters[p] = l.waiters[p][1:]
+		close(next)
+
+		return
+	}
+
+	l.inFlight--
+	limiterInFlightGauge.Set(float64(l.inFlight))
+}
+
+// recordLatency must be called with l.mu held. It tracks recent read latencies, shrinks the limit on
+// any observation that leaves the window's p99 above TargetLatency, and grows it by one after every
+// AdjustEvery consecutive observations under target.
+func (l *adaptiveLimiter) recordLatency(latency time.Duration) {
+	l.latencies = append(l.latencies, latency)
+	if len(l.latencies) > l.opts.LatencyWindow {
+		l.latencies = l.latencies[1:]
+	}
+
+	p99 := l.p99Locked()
+
+	if p99 > l.opts.TargetLatency {
+		l.decreaseLocked()
+		l.successesSince = 0
+
+		return
+	}
+
+	l.successesSince++
+	if l.successesSince >= l.opts.AdjustEvery {
+		l.increaseLocked()
+		l.successesSince = 0
+	}
+}
+
+func (l *adaptiveLimiter) increaseLocked() {
+	if l.limit < l.opts.MaxConcurrency {
+		l.limit++
+		limiterCurrentLimitGauge.Set(float64(l.limit))
+	}
+}
+
+func (l *adaptiveLimiter) decreaseLocked() {
+	newLimit := int(float64(l.limit) * l.opts.DecreaseFactor)
+	if newLimit < l.opts.MinConcurrency {
+		newLimit = l.opts.MinConcurrency
+	}
+	if newLimit != l.limit {
+		l.limit = newLimit
+		limiterCurrentLimitGauge.Set(float64(l.limit))
+	}
+}
+
+// p99Locked returns the 99th percentile of the recorded latency window. l.mu must be held.
+func (l *adaptiveLimiter) p99Locked() time.Duration {
+	if len(l.latencies) == 0 {
+		return 0
+	}
+
+	sorted := make([]time.Duration, len(l.latencies))
+	copy(sorted, l.latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	idx := int(float64(len(sorted)) * 0.99)
+	if idx >= len(sorted) {
+		idx = len(sorted) - 1
+	}
+
+	return sorted[idx]
+}
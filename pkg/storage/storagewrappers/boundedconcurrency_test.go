@@ -0,0 +1,180 @@
+package storagewrappers
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func testOptions() adaptiveLimiterOptions {
+	return adaptiveLimiterOptions{
+		MinConcurrency: 1,
+		MaxConcurrency: 10,
+		TargetLatency:  50 * time.Millisecond,
+		AdjustEvery:    3,
+		DecreaseFactor: 0.5,
+		LatencyWindow:  10,
+	}
+}
+
+func TestAdaptiveLimiterAcquireRelease(t *testing.T) {
+	l := newAdaptiveLimiter(1, testOptions())
+
+	if err := l.acquire(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if l.currentInFlight() != 1 {
+		t.Errorf("expected 1 in flight, got %d", l.currentInFlight())
+	}
+
+	l.release(time.Millisecond, false)
+
+	if l.currentInFlight() != 0 {
+		t.Errorf("expected 0 in flight after release, got %d", l.currentInFlight())
+	}
+}
+
+func TestAdaptiveLimiterAcquireBlocksUntilReleased(t *testing.T) {
+	l := newAdaptiveLimiter(1, testOptions())
+
+	if err := l.acquire(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	acquired := make(chan struct{})
+	go func() {
+		if err := l.acquire(context.Background()); err != nil {
+			t.Errorf("unexpected error: %v", err)
+		}
+		close(acquired)
+	}()
+
+	select {
+	case <-acquired:
+		t.Fatal("second acquire should have blocked while the only slot is held")
+	case <-time.After(20 * time.Millisecond):
+	}
+
+	l.release(time.Millisecond, false)
+
+	select {
+	case <-acquired:
+	case <-time.After(time.Second):
+		t.Fatal("second acquire should have unblocked after release")
+	}
+}
+
+func TestAdaptiveLimiterCancelWhileWaitingDoesNotDeadlock(t *testing.T) {
+	l := newAdaptiveLimiter(1, testOptions())
+
+	if err := l.acquire(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if err := l.acquire(ctx); err == nil {
+		t.Fatal("expected acquire to fail once its context deadline is exceeded")
+	}
+
+	l.release(time.Millisecond, false)
+
+	// The slot freed by release above must have gone back to the pool, not to the waiter that
+	// already gave up, or a subsequent acquire would incorrectly block.
+	ctx2, cancel2 := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel2()
+
+	if err := l.acquire(ctx2); err != nil {
+		t.Fatalf("expected the released slot to be acquirable, got: %v", err)
+	}
+}
+
+func TestAdaptiveLimiterPriorityOrdering(t *testing.T) {
+	l := newAdaptiveLimiter(1, testOptions())
+
+	if err := l.acquire(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var mu sync.Mutex
+	var order []string
+
+	wait := func(priority Priority, name string) {
+		ctx := ContextWithPriority(context.Background(), priority)
+		if err := l.acquire(ctx); err != nil {
+			t.Errorf("unexpected error: %v", err)
+			return
+		}
+
+		mu.Lock()
+		order = append(order, name)
+		mu.Unlock()
+	}
+
+	// Give the low-priority waiter a head start queuing up, so ordering is decided by priority,
+	// not arrival time.
+	go wait(PriorityLow, "low")
+	time.Sleep(10 * time.Millisecond)
+	go wait(PriorityHigh, "high")
+	time.Sleep(10 * time.Millisecond)
+
+	l.release(time.Millisecond, false)
+	time.Sleep(20 * time.Millisecond)
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	if len(order) != 1 || order[0] != "high" {
+		t.Errorf("expected the high priority waiter to be serviced first, got %v", order)
+	}
+}
+
+func TestAdaptiveLimiterTimeoutCausesExactlyOneDecrease(t *testing.T) {
+	opts := testOptions()
+	opts.MinConcurrency = 1
+	l := newAdaptiveLimiter(10, opts)
+
+	if err := l.acquire(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// A timed-out call releases with a large, inflated latency. If it were also fed into the p99
+	// window, it would independently trip the latency-based decrease on top of the timeout-based
+	// one, shrinking the limit twice for a single event.
+	l.release(10*time.Second, true)
+
+	if got, want := l.limit, 5; got != want {
+		t.Errorf("expected exactly one multiplicative decrease (10 -> 5), got limit=%d", got)
+	}
+
+	if len(l.latencies) != 0 {
+		t.Errorf("expected the timed-out call's latency not to be recorded in the p99 window, got %v", l.latencies)
+	}
+}
+
+func TestAdaptiveLimiterIncreasesAfterEnoughFastReads(t *testing.T) {
+	opts := testOptions()
+	l := newAdaptiveLimiter(1, opts)
+
+	for i := 0; i < opts.AdjustEvery; i++ {
+		l.release(time.Millisecond, false)
+	}
+
+	if l.limit != 2 {
+		t.Errorf("expected the limit to grow by one after %d fast reads, got %d", opts.AdjustEvery, l.limit)
+	}
+}
+
+func TestAdaptiveLimiterDecreasesWhenP99ExceedsTarget(t *testing.T) {
+	opts := testOptions()
+	l := newAdaptiveLimiter(10, opts)
+
+	l.release(opts.TargetLatency*2, false)
+
+	if l.limit != 5 {
+		t.Errorf("expected a multiplicative decrease when p99 exceeds the target, got limit=%d", l.limit)
+	}
+}
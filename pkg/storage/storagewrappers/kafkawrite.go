@@ -0,0 +1,55 @@
+package storagewrappers
+
+import (
+	"context"
+	"time"
+
+	"github.com/openfga/openfga/pkg/ingester"
+	"github.com/openfga/openfga/pkg/storage"
+	openfgapb "go.buf.build/openfga/go/openfga/api/openfga/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+var _ storage.OpenFGADatastore = (*KafkaWriteDatastore)(nil)
+
+// KafkaWriteDatastore wraps a storage.OpenFGADatastore and redirects Write to produce a
+// WriteRecord to Kafka instead of applying the mutation inline. A separate ingester.Consumer
+// applies the record asynchronously, decoupling write throughput from datastore commit latency.
+// All other methods, including reads, are delegated to the wrapped datastore unchanged.
+type KafkaWriteDatastore struct {
+	storage.OpenFGADatastore
+	producer *ingester.Producer
+}
+
+// NewKafkaWriteDatastore returns a storage.OpenFGADatastore that produces writes to producer
+// instead of applying them to wrapped directly.
+func NewKafkaWriteDatastore(wrapped storage.OpenFGADatastore, producer *ingester.Producer) *KafkaWriteDatastore {
+	return &KafkaWriteDatastore{OpenFGADatastore: wrapped, producer: producer}
+}
+
+// Write produces deletes and writes as a single WriteRecord and, on success, attaches the
+// resulting consistency token to the RPC's outgoing response header so a client can block a
+// subsequent request on it via ingester.ConsistencyTokenHeader.
+func (k *KafkaWriteDatastore) Write(ctx context.Context, store string, deletes storage.Deletes, writes storage.Writes) error {
+	record := &ingester.WriteRecord{
+		Store:     store,
+		Writes:    []*openfgapb.TupleKey(writes),
+		Deletes:   []*openfgapb.TupleKey(deletes),
+		Timestamp: time.Now(),
+	}
+
+	offset, err := k.producer.Produce(ctx, record)
+	if err != nil {
+		return err
+	}
+
+	token := ingester.EncodeConsistencyToken(store, offset)
+
+	// Best-effort: this only succeeds when ctx is the live context of an in-flight unary gRPC
+	// call, which is true for the real Write RPC. It is a no-op (and safe to ignore) in tests or
+	// any other caller that doesn't carry gRPC stream state.
+	_ = grpc.SetHeader(ctx, metadata.Pairs(ingester.ConsistencyTokenHeader, token))
+
+	return nil
+}
@@ -0,0 +1,37 @@
+package storagewrappers
+
+import "context"
+
+// Priority is the relative importance of a request to the datastore, used by
+// the adaptive concurrency limiter to decide which waiter to service next when
+// capacity frees up.
+type Priority int
+
+const (
+	// PriorityLow is used for requests that can tolerate being starved under
+	// load, e.g. ReadChanges.
+	PriorityLow Priority = iota
+	// PriorityNormal is the default priority, used e.g. for ListObjects.
+	PriorityNormal
+	// PriorityHigh is used for latency-sensitive requests, e.g. Check.
+	PriorityHigh
+)
+
+type priorityContextKey struct{}
+
+// ContextWithPriority returns a copy of ctx carrying priority, for consumption
+// by the adaptive concurrency limiter in boundedConcurrencyTupleReader.
+func ContextWithPriority(ctx context.Context, priority Priority) context.Context {
+	return context.WithValue(ctx, priorityContextKey{}, priority)
+}
+
+// PriorityFromContext returns the priority carried by ctx, defaulting to
+// PriorityNormal if none was set.
+func PriorityFromContext(ctx context.Context) Priority {
+	priority, ok := ctx.Value(priorityContextKey{}).(Priority)
+	if !ok {
+		return PriorityNormal
+	}
+
+	return priority
+}
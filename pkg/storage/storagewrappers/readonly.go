@@ -0,0 +1,49 @@
+package storagewrappers
+
+import (
+	"context"
+	"errors"
+
+	"github.com/openfga/openfga/pkg/storage"
+	openfgapb "go.buf.build/openfga/go/openfga/api/openfga/v1"
+)
+
+// ErrReadOnlyDatastore is returned by ReadOnlyDatastore for any method that would
+// mutate the underlying store.
+var ErrReadOnlyDatastore = errors.New("datastore is in read-only mode")
+
+var _ storage.OpenFGADatastore = (*ReadOnlyDatastore)(nil)
+
+// ReadOnlyDatastore wraps a storage.OpenFGADatastore and rejects every mutating
+// operation with ErrReadOnlyDatastore. It is intended for replica deployments
+// that serve Check/Read/Expand/ListObjects traffic against a follower database
+// and must never write, mirroring the split reader/writer deployment pattern.
+type ReadOnlyDatastore struct {
+	storage.OpenFGADatastore
+}
+
+// NewReadOnlyDatastore returns a storage.OpenFGADatastore that delegates all read
+// operations to wrapped and fails all mutating operations.
+func NewReadOnlyDatastore(wrapped storage.OpenFGADatastore) *ReadOnlyDatastore {
+	return &ReadOnlyDatastore{OpenFGADatastore: wrapped}
+}
+
+func (r *ReadOnlyDatastore) Write(ctx context.Context, store string, deletes storage.Deletes, writes storage.Writes) error {
+	return ErrReadOnlyDatastore
+}
+
+func (r *ReadOnlyDatastore) WriteAuthorizationModel(ctx context.Context, store string, model *openfgapb.AuthorizationModel) error {
+	return ErrReadOnlyDatastore
+}
+
+func (r *ReadOnlyDatastore) CreateStore(ctx context.Context, store *openfgapb.Store) (*openfgapb.Store, error) {
+	return nil, ErrReadOnlyDatastore
+}
+
+func (r *ReadOnlyDatastore) DeleteStore(ctx context.Context, id string) error {
+	return ErrReadOnlyDatastore
+}
+
+func (r *ReadOnlyDatastore) WriteAssertions(ctx context.Context, store, modelID string, assertions []*openfgapb.Assertion) error {
+	return ErrReadOnlyDatastore
+}
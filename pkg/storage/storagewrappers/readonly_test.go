@@ -0,0 +1,60 @@
+package storagewrappers
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/openfga/openfga/pkg/storage"
+	openfgapb "go.buf.build/openfga/go/openfga/api/openfga/v1"
+)
+
+func TestReadOnlyDatastoreRejectsMutations(t *testing.T) {
+	ro := NewReadOnlyDatastore(nil)
+	ctx := context.Background()
+
+	if err := ro.Write(ctx, "store1", nil, nil); !errors.Is(err, ErrReadOnlyDatastore) {
+		t.Errorf("Write() = %v, want ErrReadOnlyDatastore", err)
+	}
+
+	if err := ro.WriteAuthorizationModel(ctx, "store1", &openfgapb.AuthorizationModel{}); !errors.Is(err, ErrReadOnlyDatastore) {
+		t.Errorf("WriteAuthorizationModel() = %v, want ErrReadOnlyDatastore", err)
+	}
+
+	if _, err := ro.CreateStore(ctx, &openfgapb.Store{}); !errors.Is(err, ErrReadOnlyDatastore) {
+		t.Errorf("CreateStore() = %v, want ErrReadOnlyDatastore", err)
+	}
+
+	if err := ro.DeleteStore(ctx, "store1"); !errors.Is(err, ErrReadOnlyDatastore) {
+		t.Errorf("DeleteStore() = %v, want ErrReadOnlyDatastore", err)
+	}
+
+	if err := ro.WriteAssertions(ctx, "store1", "model1", nil); !errors.Is(err, ErrReadOnlyDatastore) {
+		t.Errorf("WriteAssertions() = %v, want ErrReadOnlyDatastore", err)
+	}
+}
+
+// fakeReadStore is a minimal storage.OpenFGADatastore stub used to prove ReadOnlyDatastore
+// delegates non-mutating calls through rather than also rejecting those.
+type fakeReadStore struct {
+	storage.OpenFGADatastore
+
+	model *openfgapb.AuthorizationModel
+}
+
+func (f *fakeReadStore) ReadAuthorizationModel(ctx context.Context, store, id string) (*openfgapb.AuthorizationModel, error) {
+	return f.model, nil
+}
+
+func TestReadOnlyDatastoreDelegatesReads(t *testing.T) {
+	ro := NewReadOnlyDatastore(&fakeReadStore{model: &openfgapb.AuthorizationModel{Id: "model1"}})
+
+	model, err := ro.ReadAuthorizationModel(context.Background(), "store1", "model1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if model.GetId() != "model1" {
+		t.Errorf("expected the read to be delegated to the wrapped datastore, got %q", model.GetId())
+	}
+}
@@ -0,0 +1,80 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/openfga/openfga/pkg/ingester"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// consistencyPollInterval is how often NewConsistencyInterceptor re-checks
+// Consumer.LastAppliedOffset while waiting for a write to catch up.
+const consistencyPollInterval = 10 * time.Millisecond
+
+// NewConsistencyInterceptor returns a unary interceptor that implements read-your-writes against
+// the "kafka" write path: if the incoming request carries an ingester.ConsistencyTokenHeader
+// (as returned in the response metadata of a prior Write, see storagewrappers.KafkaWriteDatastore),
+// the RPC is held until consumer reports it has applied at least that offset for the token's
+// store, or the request's context is done, whichever comes first.
+func NewConsistencyInterceptor(consumer *ingester.Consumer) grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		token, ok := consistencyTokenFromHeader(ctx)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		store, offset, err := ingester.DecodeConsistencyToken(token)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+
+		if err := waitForOffset(ctx, consumer, store, offset); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func consistencyTokenFromHeader(ctx context.Context) (string, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return "", false
+	}
+
+	values := md.Get(ingester.ConsistencyTokenHeader)
+	if len(values) == 0 {
+		return "", false
+	}
+
+	return values[0], true
+}
+
+func waitForOffset(ctx context.Context, consumer *ingester.Consumer, store string, offset int64) error {
+	if consumer.LastAppliedOffset(store) >= offset {
+		return nil
+	}
+
+	ticker := time.NewTicker(consistencyPollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return status.Error(codes.DeadlineExceeded, "timed out waiting for a prior write to become visible")
+		case <-ticker.C:
+			if consumer.LastAppliedOffset(store) >= offset {
+				return nil
+			}
+		}
+	}
+}
@@ -0,0 +1,72 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openfga/openfga/pkg/ingester"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestNewConsistencyInterceptorNoToken(t *testing.T) {
+	interceptor := NewConsistencyInterceptor(ingester.NewConsumer(nil, "", "", nil, nil))
+
+	called := false
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		called = true
+		return nil, nil
+	}
+
+	if _, err := interceptor(context.Background(), nil, &grpc.UnaryServerInfo{}, handler); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if !called {
+		t.Error("expected handler to be called when no consistency token is present")
+	}
+}
+
+func TestNewConsistencyInterceptorBlocksOnFirstWriteAtOffsetZero(t *testing.T) {
+	consumer := ingester.NewConsumer(nil, "", "", nil, nil)
+	interceptor := NewConsistencyInterceptor(consumer)
+
+	// A store's very first write produces a consistency token encoding offset 0. The consumer
+	// hasn't applied anything for this store yet (LastAppliedOffset returns -1), so the request
+	// must still be held rather than let through immediately just because 0 >= 0.
+	token := ingester.EncodeConsistencyToken("store1", 0)
+	md := metadata.Pairs(ingester.ConsistencyTokenHeader, token)
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Millisecond)
+	defer cancel()
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		t.Fatal("handler should not be called before the consumer has applied anything for this store")
+		return nil, nil
+	}
+
+	if _, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler); err == nil {
+		t.Error("expected an error once the context deadline is exceeded")
+	}
+}
+
+func TestNewConsistencyInterceptorTimesOutWhenOffsetNeverCatchesUp(t *testing.T) {
+	consumer := ingester.NewConsumer(nil, "", "", nil, nil)
+	interceptor := NewConsistencyInterceptor(consumer)
+
+	token := ingester.EncodeConsistencyToken("store1", 100)
+	md := metadata.Pairs(ingester.ConsistencyTokenHeader, token)
+	ctx := metadata.NewIncomingContext(context.Background(), md)
+	ctx, cancel := context.WithTimeout(ctx, 30*time.Millisecond)
+	defer cancel()
+
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		t.Fatal("handler should not be called before the offset catches up")
+		return nil, nil
+	}
+
+	if _, err := interceptor(ctx, nil, &grpc.UnaryServerInfo{}, handler); err == nil {
+		t.Error("expected an error once the context deadline is exceeded")
+	}
+}
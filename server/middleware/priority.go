@@ -0,0 +1,68 @@
+package middleware
+
+import (
+	"context"
+	"strings"
+
+	"github.com/openfga/openfga/pkg/storage/storagewrappers"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// priorityHeader lets a caller request a non-default datastore read priority,
+// overriding the per-RPC default below.
+const priorityHeader = "openfga-priority"
+
+// defaultPriorityByMethod assigns a datastore read priority by RPC type so that
+// a burst of ListObjects or ReadChanges traffic cannot starve Checks out of the
+// bounded concurrency limiter.
+var defaultPriorityByMethod = map[string]storagewrappers.Priority{
+	"/openfga.v1.OpenFGAService/Check":       storagewrappers.PriorityHigh,
+	"/openfga.v1.OpenFGAService/ListObjects": storagewrappers.PriorityNormal,
+	"/openfga.v1.OpenFGAService/ReadChanges": storagewrappers.PriorityLow,
+}
+
+// NewPriorityInterceptor returns a unary interceptor that annotates the request
+// context with a storagewrappers.Priority, read from the priorityHeader
+// metadata if present, or else derived from the RPC method.
+func NewPriorityInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		priority, ok := priorityFromHeader(ctx)
+		if !ok {
+			priority, ok = defaultPriorityByMethod[info.FullMethod]
+		}
+		if !ok {
+			priority = storagewrappers.PriorityNormal
+		}
+
+		return handler(storagewrappers.ContextWithPriority(ctx, priority), req)
+	}
+}
+
+func priorityFromHeader(ctx context.Context) (storagewrappers.Priority, bool) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, false
+	}
+
+	values := md.Get(priorityHeader)
+	if len(values) == 0 {
+		return 0, false
+	}
+
+	switch strings.ToLower(values[0]) {
+	case "low":
+		return storagewrappers.PriorityLow, true
+	case "normal":
+		return storagewrappers.PriorityNormal, true
+	case "high":
+		return storagewrappers.PriorityHigh, true
+	default:
+		return 0, false
+	}
+}
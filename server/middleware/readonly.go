@@ -0,0 +1,37 @@
+package middleware
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// readOnlyRejectedMethods lists the fully qualified gRPC method names that mutate
+// store state and must be rejected while the server is running in read-only mode.
+var readOnlyRejectedMethods = map[string]bool{
+	"/openfga.v1.OpenFGAService/Write":           true,
+	"/openfga.v1.OpenFGAService/WriteAssertions": true,
+	"/openfga.v1.OpenFGAService/CreateStore":     true,
+	"/openfga.v1.OpenFGAService/DeleteStore":     true,
+}
+
+// NewReadOnlyInterceptor returns a unary interceptor that short-circuits any
+// mutating RPC with a FailedPrecondition error, without ever reaching the
+// datastore. It is meant to be paired with storagewrappers.ReadOnlyDatastore so
+// that a replica deployment is read-only at both the API and the storage boundary.
+func NewReadOnlyInterceptor() grpc.UnaryServerInterceptor {
+	return func(
+		ctx context.Context,
+		req interface{},
+		info *grpc.UnaryServerInfo,
+		handler grpc.UnaryHandler,
+	) (interface{}, error) {
+		if readOnlyRejectedMethods[info.FullMethod] {
+			return nil, status.Error(codes.FailedPrecondition, "server is running in read-only mode")
+		}
+
+		return handler(ctx, req)
+	}
+}
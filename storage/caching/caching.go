@@ -0,0 +1,134 @@
+// Package caching wraps a storage.OpenFGADatastore so that ReadAuthorizationModel is served out of
+// a pkg/storage/caching.CacheBackend. With a shared backend (Redis or Memcached) a rolling deploy or
+// horizontal scale-out doesn't start every replica with a cold cache; the in-process memory backend
+// is not shared across replicas.
+package caching
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/openfga/openfga/pkg/storage"
+	pkgcaching "github.com/openfga/openfga/pkg/storage/caching"
+	openfgapb "go.buf.build/openfga/go/openfga/api/openfga/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// epochTTL is effectively "never expires": the epoch counter is invalidated by being incremented,
+// not by falling out of the cache.
+const epochTTL = 100 * 365 * 24 * time.Hour
+
+var _ storage.OpenFGADatastore = (*CachedOpenFGADatastore)(nil)
+
+// CachedOpenFGADatastore wraps a storage.OpenFGADatastore, caching ReadAuthorizationModel results
+// in backend under a key namespaced by the store's current cache epoch (see
+// pkg/storage/caching.EpochKey). WriteAuthorizationModel bumps that epoch, so every replica's
+// cached entries for the store stop being served immediately, without a bulk cache delete.
+type CachedOpenFGADatastore struct {
+	storage.OpenFGADatastore
+
+	backend   pkgcaching.CacheBackend
+	ttl       time.Duration
+	keyPrefix string
+}
+
+// NewCachedOpenFGADatastore returns a storage.OpenFGADatastore that serves authorization model
+// reads out of backend, namespacing keys with keyPrefix and expiring fresh entries after ttl.
+// backend is responsible for bounding its own size (e.g. pkgcaching.NewMemoryCacheBackend's
+// maxEntries) -- CachedOpenFGADatastore itself has no cardinality limit of its own to enforce,
+// since Check-result keys are hashed from the full tuple and so are effectively unbounded.
+func NewCachedOpenFGADatastore(
+	wrapped storage.OpenFGADatastore,
+	backend pkgcaching.CacheBackend,
+	ttl time.Duration,
+	keyPrefix string,
+) *CachedOpenFGADatastore {
+	return &CachedOpenFGADatastore{
+		OpenFGADatastore: wrapped,
+		backend:          backend,
+		ttl:              ttl,
+		keyPrefix:        keyPrefix,
+	}
+}
+
+// ReadAuthorizationModel serves model reads out of the cache when possible, falling back to and
+// populating from the wrapped datastore on a miss.
+func (c *CachedOpenFGADatastore) ReadAuthorizationModel(ctx context.Context, store, id string) (*openfgapb.AuthorizationModel, error) {
+	key := pkgcaching.ModelCacheKey(c.keyPrefix, store, id, c.epoch(ctx, store))
+
+	if cached, ok, err := c.backend.Get(ctx, key); err == nil && ok {
+		model := &openfgapb.AuthorizationModel{}
+		if err := protojson.Unmarshal(cached, model); err == nil {
+			return model, nil
+		}
+	}
+
+	model, err := c.OpenFGADatastore.ReadAuthorizationModel(ctx, store, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if serialized, err := protojson.Marshal(model); err == nil {
+		_ = c.backend.Set(ctx, key, serialized, c.ttl)
+	}
+
+	return model, nil
+}
+
+// WriteAuthorizationModel writes model to the wrapped datastore and then bumps store's cache
+// epoch, so that any already-cached model for store is no longer served.
+func (c *CachedOpenFGADatastore) WriteAuthorizationModel(ctx context.Context, store string, model *openfgapb.AuthorizationModel) error {
+	if err := c.OpenFGADatastore.WriteAuthorizationModel(ctx, store, model); err != nil {
+		return err
+	}
+
+	return c.bumpEpoch(ctx, store)
+}
+
+// CachedCheckResult returns a previously cached Check result for tupleKey under store/modelID, and
+// false if none is cached. It is exported for the Check resolver to call directly, since Check
+// sub-result caching is a resolution-time concern rather than something ReadAuthorizationModel or
+// Write can hook into on their own.
+func (c *CachedOpenFGADatastore) CachedCheckResult(ctx context.Context, store, modelID, tupleKey string) (allowed bool, ok bool) {
+	key := pkgcaching.CheckCacheKey(c.keyPrefix, store, modelID, pkgcaching.HashTupleKey(tupleKey), c.epoch(ctx, store))
+
+	cached, found, err := c.backend.Get(ctx, key)
+	if err != nil || !found {
+		return false, false
+	}
+
+	return string(cached) == "1", true
+}
+
+// SetCachedCheckResult caches allowed for tupleKey under store/modelID.
+func (c *CachedOpenFGADatastore) SetCachedCheckResult(ctx context.Context, store, modelID, tupleKey string, allowed bool) error {
+	key := pkgcaching.CheckCacheKey(c.keyPrefix, store, modelID, pkgcaching.HashTupleKey(tupleKey), c.epoch(ctx, store))
+
+	value := "0"
+	if allowed {
+		value = "1"
+	}
+
+	return c.backend.Set(ctx, key, []byte(value), c.ttl)
+}
+
+func (c *CachedOpenFGADatastore) epoch(ctx context.Context, store string) uint64 {
+	value, ok, err := c.backend.Get(ctx, pkgcaching.EpochKey(c.keyPrefix, store))
+	if err != nil || !ok {
+		return 0
+	}
+
+	epoch, err := strconv.ParseUint(string(value), 10, 64)
+	if err != nil {
+		return 0
+	}
+
+	return epoch
+}
+
+func (c *CachedOpenFGADatastore) bumpEpoch(ctx context.Context, store string) error {
+	next := c.epoch(ctx, store) + 1
+
+	return c.backend.Set(ctx, pkgcaching.EpochKey(c.keyPrefix, store), []byte(strconv.FormatUint(next, 10)), epochTTL)
+}
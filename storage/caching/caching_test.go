@@ -0,0 +1,132 @@
+package caching
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/openfga/openfga/pkg/storage"
+	pkgcaching "github.com/openfga/openfga/pkg/storage/caching"
+	openfgapb "go.buf.build/openfga/go/openfga/api/openfga/v1"
+)
+
+// fakeModelStore is a minimal storage.OpenFGADatastore stub that only implements the two methods
+// CachedOpenFGADatastore actually calls through to, and counts ReadAuthorizationModel calls so
+// tests can assert on cache hits vs misses.
+type fakeModelStore struct {
+	storage.OpenFGADatastore
+
+	model *openfgapb.AuthorizationModel
+	reads int
+}
+
+func (f *fakeModelStore) ReadAuthorizationModel(ctx context.Context, store, id string) (*openfgapb.AuthorizationModel, error) {
+	f.reads++
+
+	return f.model, nil
+}
+
+func (f *fakeModelStore) WriteAuthorizationModel(ctx context.Context, store string, model *openfgapb.AuthorizationModel) error {
+	f.model = model
+
+	return nil
+}
+
+func TestReadAuthorizationModelIsCachedAcrossCalls(t *testing.T) {
+	fake := &fakeModelStore{model: &openfgapb.AuthorizationModel{Id: "model1", SchemaVersion: "1.1"}}
+	cached := NewCachedOpenFGADatastore(fake, pkgcaching.NewMemoryCacheBackend(0), time.Minute, "test")
+
+	ctx := context.Background()
+
+	for i := 0; i < 3; i++ {
+		model, err := cached.ReadAuthorizationModel(ctx, "store1", "model1")
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+
+		if model.GetId() != "model1" {
+			t.Errorf("expected model1, got %q", model.GetId())
+		}
+	}
+
+	if fake.reads != 1 {
+		t.Errorf("expected ReadAuthorizationModel on the wrapped datastore to be called once, got %d", fake.reads)
+	}
+}
+
+func TestWriteAuthorizationModelInvalidatesCache(t *testing.T) {
+	fake := &fakeModelStore{model: &openfgapb.AuthorizationModel{Id: "model1", SchemaVersion: "1.1"}}
+	cached := NewCachedOpenFGADatastore(fake, pkgcaching.NewMemoryCacheBackend(0), time.Minute, "test")
+
+	ctx := context.Background()
+
+	if _, err := cached.ReadAuthorizationModel(ctx, "store1", "model1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if err := cached.WriteAuthorizationModel(ctx, "store1", &openfgapb.AuthorizationModel{Id: "model1", SchemaVersion: "1.1"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if _, err := cached.ReadAuthorizationModel(ctx, "store1", "model1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if fake.reads != 2 {
+		t.Errorf("expected the post-write read to miss the now-stale cache entry and hit the datastore again, got %d reads", fake.reads)
+	}
+}
+
+func TestCheckResultCache(t *testing.T) {
+	fake := &fakeModelStore{}
+	cached := NewCachedOpenFGADatastore(fake, pkgcaching.NewMemoryCacheBackend(0), time.Minute, "test")
+
+	ctx := context.Background()
+
+	if _, ok := cached.CachedCheckResult(ctx, "store1", "model1", "document:1#viewer@user:anne"); ok {
+		t.Error("expected no cached result before SetCachedCheckResult")
+	}
+
+	if err := cached.SetCachedCheckResult(ctx, "store1", "model1", "document:1#viewer@user:anne", true); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	allowed, ok := cached.CachedCheckResult(ctx, "store1", "model1", "document:1#viewer@user:anne")
+	if !ok || !allowed {
+		t.Errorf("CachedCheckResult() = (%v, %v), want (true, true)", allowed, ok)
+	}
+}
+
+// TestSharedBackendServesAcrossReplicas simulates two replicas, each with their own
+// CachedOpenFGADatastore and underlying datastore connection, sharing one distributed
+// CacheBackend (Redis/Memcached in production). It stands in for a rolling restart: replica B
+// comes up cold but must still serve the model replica A already cached, without ever touching
+// its own datastore.
+func TestSharedBackendServesAcrossReplicas(t *testing.T) {
+	sharedBackend := pkgcaching.NewMemoryCacheBackend(0)
+
+	replicaAStore := &fakeModelStore{model: &openfgapb.AuthorizationModel{Id: "model1", SchemaVersion: "1.1"}}
+	replicaA := NewCachedOpenFGADatastore(replicaAStore, sharedBackend, time.Minute, "test")
+
+	replicaBStore := &fakeModelStore{} // cold: would return a zero-value model if ever queried
+	replicaB := NewCachedOpenFGADatastore(replicaBStore, sharedBackend, time.Minute, "test")
+
+	ctx := context.Background()
+
+	if _, err := replicaA.ReadAuthorizationModel(ctx, "store1", "model1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	model, err := replicaB.ReadAuthorizationModel(ctx, "store1", "model1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if model.GetId() != "model1" {
+		t.Errorf("expected replica B to serve the model cached by replica A, got %q", model.GetId())
+	}
+
+	if replicaBStore.reads != 0 {
+		t.Errorf("expected replica B to never query its own datastore, got %d reads", replicaBStore.reads)
+	}
+}
@@ -0,0 +1,59 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+
+	openfgapb "go.buf.build/openfga/go/openfga/api/openfga/v1"
+)
+
+// assertionsWrapper lets a repeated Assertion field round-trip through encoding/json as a single blob,
+// mirroring how storage/postgres stores the whole assertions list for a model in one row.
+type assertionsWrapper struct {
+	Assertions []*openfgapb.Assertion `json:"assertions"`
+}
+
+// WriteAssertions replaces the full set of assertions recorded against store/modelID.
+func (d *Datastore) WriteAssertions(ctx context.Context, store, modelID string, assertions []*openfgapb.Assertion) error {
+	serialized, err := json.Marshal(&assertionsWrapper{Assertions: assertions})
+	if err != nil {
+		return err
+	}
+
+	_, err = d.db.ExecContext(
+		ctx,
+		`INSERT INTO assertion (store, authorization_model_id, assertions) VALUES (?, ?, ?)
+		 ON DUPLICATE KEY UPDATE assertions = VALUES(assertions)`,
+		store, modelID, serialized,
+	)
+
+	return err
+}
+
+// ReadAssertions returns the assertions recorded against store/modelID, or an empty slice if none
+// have been written yet.
+func (d *Datastore) ReadAssertions(ctx context.Context, store, modelID string) ([]*openfgapb.Assertion, error) {
+	var serialized []byte
+
+	row := d.db.QueryRowContext(
+		ctx,
+		"SELECT assertions FROM assertion WHERE store = ? AND authorization_model_id = ?",
+		store, modelID,
+	)
+
+	if err := row.Scan(&serialized); err != nil {
+		if err == sql.ErrNoRows {
+			return []*openfgapb.Assertion{}, nil
+		}
+
+		return nil, err
+	}
+
+	wrapper := &assertionsWrapper{}
+	if err := json.Unmarshal(serialized, wrapper); err != nil {
+		return nil, err
+	}
+
+	return wrapper.Assertions, nil
+}
@@ -0,0 +1,72 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/openfga/openfga/pkg/storage"
+	openfgapb "go.buf.build/openfga/go/openfga/api/openfga/v1"
+)
+
+// ReadChanges returns a page of the tuple changelog for store, optionally filtered to objectType,
+// excluding any change within horizonOffset of now so that a caller paging through changes never
+// observes a write that is still in flight.
+func (d *Datastore) ReadChanges(ctx context.Context, store, objectType string, opts storage.PaginationOptions, horizonOffset time.Duration) ([]*openfgapb.TupleChange, []byte, error) {
+	query := "SELECT object_type, object_id, relation, _user, operation, inserted_at FROM changelog WHERE store = ? AND inserted_at <= ?"
+	args := []interface{}{store, time.Now().Add(-horizonOffset)}
+
+	if objectType != "" {
+		query += " AND object_type = ?"
+		args = append(args, objectType)
+	}
+
+	query += " ORDER BY inserted_at ASC"
+	query, args = paginate(query, args, opts)
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var changes []*openfgapb.TupleChange
+
+	for rows.Next() {
+		var changeObjectType, objectID, relation, user string
+		var operation int32
+		var insertedAt sql.NullTime
+
+		if err := rows.Scan(&changeObjectType, &objectID, &relation, &user, &operation, &insertedAt); err != nil {
+			return nil, nil, err
+		}
+
+		change := &openfgapb.TupleChange{
+			TupleKey: &openfgapb.TupleKey{
+				Object:   changeObjectType + ":" + objectID,
+				Relation: relation,
+				User:     user,
+			},
+			Operation: openfgapb.TupleOperation(operation),
+		}
+
+		if insertedAt.Valid {
+			change.Timestamp = timestampProto(insertedAt.Time)
+		}
+
+		changes = append(changes, change)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	if len(changes) == 0 {
+		return nil, nil, storage.ErrNotFound
+	}
+
+	n, hasMore := truncate(len(changes), opts.PageSize)
+	changes = changes[:n]
+
+	return changes, continuationToken(opts, hasMore), nil
+}
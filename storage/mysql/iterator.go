@@ -0,0 +1,68 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/openfga/openfga/pkg/storage"
+	openfgapb "go.buf.build/openfga/go/openfga/api/openfga/v1"
+)
+
+// sqlTupleIterator adapts a *sql.Rows streaming tuple rows into a storage.TupleIterator,
+// mirroring storage/postgres's row-scanning iterator.
+type sqlTupleIterator struct {
+	rows *sql.Rows
+}
+
+func (s *sqlTupleIterator) Next(ctx context.Context) (*openfgapb.Tuple, error) {
+	if !s.rows.Next() {
+		if err := s.rows.Err(); err != nil {
+			return nil, err
+		}
+
+		return nil, storage.ErrIteratorDone
+	}
+
+	return scanTuple(s.rows)
+}
+
+func (s *sqlTupleIterator) Stop() {
+	s.rows.Close()
+}
+
+// emptyTupleIterator is a storage.TupleIterator over zero tuples, for callers that never issued a
+// query (e.g. ReadStartingWithUser with no UserFilter entries) and so have no *sql.Rows to wrap.
+type emptyTupleIterator struct{}
+
+func (emptyTupleIterator) Next(ctx context.Context) (*openfgapb.Tuple, error) {
+	return nil, storage.ErrIteratorDone
+}
+
+func (emptyTupleIterator) Stop() {}
+
+func scanTuple(scanner interface {
+	Scan(dest ...interface{}) error
+}) (*openfgapb.Tuple, error) {
+	var objectType, objectID, relation, user string
+	var conditionName sql.NullString
+	var conditionContext []byte
+	var insertedAt sql.NullTime
+
+	if err := scanner.Scan(&objectType, &objectID, &relation, &user, &conditionName, &conditionContext, &insertedAt); err != nil {
+		return nil, err
+	}
+
+	tupleKey := &openfgapb.TupleKey{
+		Object:   objectType + ":" + objectID,
+		Relation: relation,
+		User:     user,
+	}
+
+	tuple := &openfgapb.Tuple{Key: tupleKey}
+
+	if insertedAt.Valid {
+		tuple.Timestamp = timestampProto(insertedAt.Time)
+	}
+
+	return tuple, nil
+}
@@ -0,0 +1,115 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/openfga/openfga/pkg/storage"
+	openfgapb "go.buf.build/openfga/go/openfga/api/openfga/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+// ReadAuthorizationModel returns the authorization model with id, or storage.ErrNotFound.
+func (d *Datastore) ReadAuthorizationModel(ctx context.Context, store, id string) (*openfgapb.AuthorizationModel, error) {
+	var serialized []byte
+
+	row := d.db.QueryRowContext(
+		ctx,
+		"SELECT model FROM authorization_model WHERE store = ? AND authorization_model_id = ?",
+		store, id,
+	)
+
+	if err := row.Scan(&serialized); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	model := &openfgapb.AuthorizationModel{}
+	if err := protojson.Unmarshal(serialized, model); err != nil {
+		return nil, err
+	}
+
+	return model, nil
+}
+
+// ReadAuthorizationModels returns a page of the authorization models for store, newest first.
+func (d *Datastore) ReadAuthorizationModels(ctx context.Context, store string, opts storage.PaginationOptions) ([]*openfgapb.AuthorizationModel, []byte, error) {
+	query, args := paginate(
+		"SELECT model FROM authorization_model WHERE store = ? ORDER BY authorization_model_id DESC",
+		[]interface{}{store},
+		opts,
+	)
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var models []*openfgapb.AuthorizationModel
+
+	for rows.Next() {
+		var serialized []byte
+		if err := rows.Scan(&serialized); err != nil {
+			return nil, nil, err
+		}
+
+		model := &openfgapb.AuthorizationModel{}
+		if err := protojson.Unmarshal(serialized, model); err != nil {
+			return nil, nil, err
+		}
+
+		models = append(models, model)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	n, hasMore := truncate(len(models), opts.PageSize)
+	models = models[:n]
+
+	return models, continuationToken(opts, hasMore), nil
+}
+
+// FindLatestAuthorizationModelID returns the id of the most recently written authorization model
+// for store, or storage.ErrNotFound if store has none.
+func (d *Datastore) FindLatestAuthorizationModelID(ctx context.Context, store string) (string, error) {
+	var id string
+
+	row := d.db.QueryRowContext(
+		ctx,
+		"SELECT authorization_model_id FROM authorization_model WHERE store = ? ORDER BY authorization_model_id DESC LIMIT 1",
+		store,
+	)
+
+	if err := row.Scan(&id); err != nil {
+		if err == sql.ErrNoRows {
+			return "", storage.ErrNotFound
+		}
+
+		return "", err
+	}
+
+	return id, nil
+}
+
+// WriteAuthorizationModel persists model for store. Authorization models are immutable once
+// written, so this is always an insert, never an update.
+func (d *Datastore) WriteAuthorizationModel(ctx context.Context, store string, model *openfgapb.AuthorizationModel) error {
+	serialized, err := protojson.Marshal(model)
+	if err != nil {
+		return err
+	}
+
+	_, err = d.db.ExecContext(
+		ctx,
+		"INSERT INTO authorization_model (store, authorization_model_id, schema_version, model) VALUES (?, ?, ?, ?)",
+		store, model.GetId(), model.GetSchemaVersion(), serialized,
+	)
+
+	return err
+}
@@ -0,0 +1,43 @@
+package mysql
+
+import (
+	"strconv"
+
+	"github.com/openfga/openfga/pkg/storage"
+)
+
+// paginate appends a LIMIT/OFFSET clause derived from opts to query, decoding opts.From (if set)
+// as the offset to resume from. It asks for one extra row over opts.PageSize so the caller can
+// tell whether a further page exists without a separate COUNT query.
+func paginate(query string, args []interface{}, opts storage.PaginationOptions) (string, []interface{}) {
+	if opts.PageSize <= 0 {
+		return query, args
+	}
+
+	offset, _ := strconv.Atoi(opts.From)
+
+	query += " LIMIT ? OFFSET ?"
+
+	return query, append(args, opts.PageSize+1, offset)
+}
+
+// truncate drops paginate's lookahead row (if present) and returns whether more results remain.
+func truncate(count, pageSize int) (n int, hasMore bool) {
+	if pageSize <= 0 || count <= pageSize {
+		return count, false
+	}
+
+	return pageSize, true
+}
+
+// continuationToken returns the token for the next page, given the offset opts.From resumed from
+// and the page size just served.
+func continuationToken(opts storage.PaginationOptions, hasMore bool) []byte {
+	if !hasMore {
+		return nil
+	}
+
+	offset, _ := strconv.Atoi(opts.From)
+
+	return []byte(strconv.Itoa(offset + opts.PageSize))
+}
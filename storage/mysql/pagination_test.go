@@ -0,0 +1,75 @@
+package mysql
+
+import (
+	"testing"
+
+	"github.com/openfga/openfga/pkg/storage"
+)
+
+func TestParseObject(t *testing.T) {
+	tests := []struct {
+		object   string
+		wantType string
+		wantID   string
+	}{
+		{"document:1", "document", "1"},
+		{"group:eng#member", "group", "eng#member"},
+		{"malformed", "malformed", ""},
+	}
+
+	for _, test := range tests {
+		objectType, objectID := parseObject(test.object)
+		if objectType != test.wantType || objectID != test.wantID {
+			t.Errorf("parseObject(%q) = (%q, %q), want (%q, %q)", test.object, objectType, objectID, test.wantType, test.wantID)
+		}
+	}
+}
+
+func TestPaginateNoPageSize(t *testing.T) {
+	query, args := paginate("SELECT 1", []interface{}{"store"}, storage.PaginationOptions{})
+
+	if query != "SELECT 1" {
+		t.Errorf("expected query to be unchanged, got %q", query)
+	}
+
+	if len(args) != 1 {
+		t.Errorf("expected args to be unchanged, got %v", args)
+	}
+}
+
+func TestPaginateAppendsLimitAndOffset(t *testing.T) {
+	query, args := paginate("SELECT 1", []interface{}{"store"}, storage.PaginationOptions{PageSize: 10, From: "20"})
+
+	if query != "SELECT 1 LIMIT ? OFFSET ?" {
+		t.Errorf("unexpected query: %q", query)
+	}
+
+	if len(args) != 3 || args[1] != 11 || args[2] != 20 {
+		t.Errorf("unexpected args: %v", args)
+	}
+}
+
+func TestTruncate(t *testing.T) {
+	if n, hasMore := truncate(5, 0); n != 5 || hasMore {
+		t.Errorf("truncate with no page size should be a no-op, got (%d, %v)", n, hasMore)
+	}
+
+	if n, hasMore := truncate(5, 10); n != 5 || hasMore {
+		t.Errorf("truncate under page size should be a no-op, got (%d, %v)", n, hasMore)
+	}
+
+	if n, hasMore := truncate(11, 10); n != 10 || !hasMore {
+		t.Errorf("truncate over page size should drop the lookahead row, got (%d, %v)", n, hasMore)
+	}
+}
+
+func TestContinuationToken(t *testing.T) {
+	if tok := continuationToken(storage.PaginationOptions{PageSize: 10, From: "20"}, false); tok != nil {
+		t.Errorf("expected no token when hasMore is false, got %q", tok)
+	}
+
+	tok := continuationToken(storage.PaginationOptions{PageSize: 10, From: "20"}, true)
+	if string(tok) != "30" {
+		t.Errorf("expected continuation token %q, got %q", "30", tok)
+	}
+}
@@ -0,0 +1,107 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/openfga/openfga/pkg/storage"
+	openfgapb "go.buf.build/openfga/go/openfga/api/openfga/v1"
+)
+
+const storeColumns = "id, name, created_at, updated_at"
+
+func scanStore(scanner interface {
+	Scan(dest ...interface{}) error
+}) (*openfgapb.Store, error) {
+	var id, name string
+	var createdAt, updatedAt sql.NullTime
+
+	if err := scanner.Scan(&id, &name, &createdAt, &updatedAt); err != nil {
+		return nil, err
+	}
+
+	s := &openfgapb.Store{Id: id, Name: name}
+
+	if createdAt.Valid {
+		s.CreatedAt = timestampProto(createdAt.Time)
+	}
+
+	if updatedAt.Valid {
+		s.UpdatedAt = timestampProto(updatedAt.Time)
+	}
+
+	return s, nil
+}
+
+// CreateStore persists a new store and returns it with its timestamps populated.
+func (d *Datastore) CreateStore(ctx context.Context, store *openfgapb.Store) (*openfgapb.Store, error) {
+	if _, err := d.db.ExecContext(
+		ctx,
+		"INSERT INTO store (id, name, created_at, updated_at) VALUES (?, ?, NOW(), NOW())",
+		store.GetId(), store.GetName(),
+	); err != nil {
+		return nil, err
+	}
+
+	return d.GetStore(ctx, store.GetId())
+}
+
+// GetStore returns the store with id, or storage.ErrNotFound.
+func (d *Datastore) GetStore(ctx context.Context, id string) (*openfgapb.Store, error) {
+	query := "SELECT " + storeColumns + " FROM store WHERE id = ? AND deleted_at IS NULL"
+
+	row := d.db.QueryRowContext(ctx, query, id)
+
+	s, err := scanStore(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// ListStores returns a page of the non-deleted stores, newest first.
+func (d *Datastore) ListStores(ctx context.Context, opts storage.PaginationOptions) ([]*openfgapb.Store, []byte, error) {
+	query, args := paginate(
+		"SELECT "+storeColumns+" FROM store WHERE deleted_at IS NULL ORDER BY id DESC",
+		nil,
+		opts,
+	)
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var stores []*openfgapb.Store
+
+	for rows.Next() {
+		s, err := scanStore(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		stores = append(stores, s)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	n, hasMore := truncate(len(stores), opts.PageSize)
+	stores = stores[:n]
+
+	return stores, continuationToken(opts, hasMore), nil
+}
+
+// DeleteStore soft-deletes the store with id, so that historical reads/audits can still find it.
+func (d *Datastore) DeleteStore(ctx context.Context, id string) error {
+	_, err := d.db.ExecContext(ctx, "UPDATE store SET deleted_at = NOW() WHERE id = ?", id)
+
+	return err
+}
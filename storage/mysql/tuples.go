@@ -0,0 +1,256 @@
+package mysql
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/openfga/openfga/pkg/storage"
+	openfgapb "go.buf.build/openfga/go/openfga/api/openfga/v1"
+	"google.golang.org/protobuf/encoding/protojson"
+)
+
+const tupleColumns = "object_type, object_id, relation, _user, condition_name, condition_context, inserted_at"
+
+// parseObject splits a TupleKey's "type:id" object into its two columns.
+func parseObject(object string) (objectType, objectID string) {
+	parts := strings.SplitN(object, ":", 2)
+	if len(parts) != 2 {
+		return object, ""
+	}
+
+	return parts[0], parts[1]
+}
+
+// Read returns an iterator over every tuple matching tupleKey, with unset fields on tupleKey
+// treated as wildcards.
+func (d *Datastore) Read(ctx context.Context, store string, tupleKey *openfgapb.TupleKey) (storage.TupleIterator, error) {
+	query, args := tupleFilterQuery(store, tupleKey)
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sqlTupleIterator{rows: rows}, nil
+}
+
+// ReadPage is like Read, but returns a single page of tuples plus a continuation token, for
+// callers that page through results (e.g. the ListObjects and Expand APIs) instead of streaming.
+func (d *Datastore) ReadPage(ctx context.Context, store string, tupleKey *openfgapb.TupleKey, opts storage.PaginationOptions) ([]*openfgapb.Tuple, []byte, error) {
+	query, args := tupleFilterQuery(store, tupleKey)
+	query, args = paginate(query, args, opts)
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer rows.Close()
+
+	var tuples []*openfgapb.Tuple
+
+	for rows.Next() {
+		tuple, err := scanTuple(rows)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		tuples = append(tuples, tuple)
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, nil, err
+	}
+
+	n, hasMore := truncate(len(tuples), opts.PageSize)
+	tuples = tuples[:n]
+
+	return tuples, continuationToken(opts, hasMore), nil
+}
+
+// tupleFilterQuery builds a WHERE clause out of whichever fields of tupleKey are set.
+func tupleFilterQuery(store string, tupleKey *openfgapb.TupleKey) (string, []interface{}) {
+	query := fmt.Sprintf("SELECT %s FROM tuple WHERE store = ?", tupleColumns)
+	args := []interface{}{store}
+
+	if object := tupleKey.GetObject(); object != "" {
+		objectType, objectID := parseObject(object)
+		query += " AND object_type = ?"
+		args = append(args, objectType)
+
+		if objectID != "" {
+			query += " AND object_id = ?"
+			args = append(args, objectID)
+		}
+	}
+
+	if relation := tupleKey.GetRelation(); relation != "" {
+		query += " AND relation = ?"
+		args = append(args, relation)
+	}
+
+	if user := tupleKey.GetUser(); user != "" {
+		query += " AND _user = ?"
+		args = append(args, user)
+	}
+
+	return query, args
+}
+
+// ReadUserTuple returns the single tuple exactly matching tupleKey, or storage.ErrNotFound.
+func (d *Datastore) ReadUserTuple(ctx context.Context, store string, tupleKey *openfgapb.TupleKey) (*openfgapb.Tuple, error) {
+	objectType, objectID := parseObject(tupleKey.GetObject())
+
+	query := fmt.Sprintf(
+		"SELECT %s FROM tuple WHERE store = ? AND object_type = ? AND object_id = ? AND relation = ? AND _user = ?",
+		tupleColumns,
+	)
+
+	row := d.db.QueryRowContext(ctx, query, store, objectType, objectID, tupleKey.GetRelation(), tupleKey.GetUser())
+
+	tuple, err := scanTuple(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, storage.ErrNotFound
+		}
+
+		return nil, err
+	}
+
+	return tuple, nil
+}
+
+// ReadUsersetTuples returns every tuple for filter.Object/filter.Relation whose user is a userset
+// (i.e. "type:id#relation") rather than a concrete object or "*".
+func (d *Datastore) ReadUsersetTuples(ctx context.Context, store string, filter storage.ReadUsersetTuplesFilter) (storage.TupleIterator, error) {
+	objectType, objectID := parseObject(filter.Object)
+
+	query := fmt.Sprintf(
+		"SELECT %s FROM tuple WHERE store = ? AND object_type = ? AND object_id = ? AND relation = ? AND _user LIKE '%%#%%'",
+		tupleColumns,
+	)
+
+	rows, err := d.db.QueryContext(ctx, query, store, objectType, objectID, filter.Relation)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sqlTupleIterator{rows: rows}, nil
+}
+
+// ReadStartingWithUser returns every tuple for filter.ObjectType/filter.Relation whose user is one
+// of filter.UserFilter, used to resolve reverse-expand style queries.
+func (d *Datastore) ReadStartingWithUser(ctx context.Context, store string, filter storage.ReadStartingWithUserFilter) (storage.TupleIterator, error) {
+	if len(filter.UserFilter) == 0 {
+		return emptyTupleIterator{}, nil
+	}
+
+	query := fmt.Sprintf(
+		"SELECT %s FROM tuple WHERE store = ? AND object_type = ? AND relation = ? AND _user IN (%s)",
+		tupleColumns,
+		strings.TrimSuffix(strings.Repeat("?,", len(filter.UserFilter)), ","),
+	)
+
+	args := []interface{}{store, filter.ObjectType, filter.Relation}
+	for _, u := range filter.UserFilter {
+		args = append(args, userFilterValue(u))
+	}
+
+	rows, err := d.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sqlTupleIterator{rows: rows}, nil
+}
+
+// userFilterValue builds the _user column value to match against for u. Userset-typed _user
+// values are stored as "object#relation" (see the _user LIKE '%#%' filter in ReadUsersetTuples
+// above), so a non-empty Relation must be appended with a "#" separator rather than concatenated
+// directly onto Object.
+func userFilterValue(u *openfgapb.ObjectRelation) string {
+	if relation := u.GetRelation(); relation != "" {
+		return u.GetObject() + "#" + relation
+	}
+
+	return u.GetObject()
+}
+
+const changelogColumns = "store, object_type, object_id, relation, _user, operation, inserted_at"
+
+// Write atomically deletes and then inserts the given tuples, recording a changelog row per
+// mutated tuple so that ReadChanges observes the same writes.
+func (d *Datastore) Write(ctx context.Context, store string, deletes storage.Deletes, writes storage.Writes) error {
+	tx, err := d.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	for _, tk := range deletes {
+		objectType, objectID := parseObject(tk.GetObject())
+
+		if _, err := tx.ExecContext(
+			ctx,
+			"DELETE FROM tuple WHERE store = ? AND object_type = ? AND object_id = ? AND relation = ? AND _user = ?",
+			store, objectType, objectID, tk.GetRelation(), tk.GetUser(),
+		); err != nil {
+			return err
+		}
+
+		if err := insertChangelogRow(ctx, tx, store, objectType, objectID, tk, openfgapb.TupleOperation_TUPLE_OPERATION_DELETE); err != nil {
+			return err
+		}
+	}
+
+	for _, tk := range writes {
+		objectType, objectID := parseObject(tk.GetObject())
+
+		if _, err := tx.ExecContext(
+			ctx,
+			"INSERT INTO tuple (store, object_type, object_id, relation, _user, condition_name, condition_context, inserted_at) VALUES (?, ?, ?, ?, ?, ?, ?, NOW())",
+			store, objectType, objectID, tk.GetRelation(), tk.GetUser(), conditionName(tk), conditionContext(tk),
+		); err != nil {
+			return err
+		}
+
+		if err := insertChangelogRow(ctx, tx, store, objectType, objectID, tk, openfgapb.TupleOperation_TUPLE_OPERATION_WRITE); err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
+func insertChangelogRow(ctx context.Context, tx *sql.Tx, store, objectType, objectID string, tk *openfgapb.TupleKey, operation openfgapb.TupleOperation) error {
+	_, err := tx.ExecContext(
+		ctx,
+		fmt.Sprintf("INSERT INTO changelog (%s) VALUES (?, ?, ?, ?, ?, ?, NOW())", changelogColumns),
+		store, objectType, objectID, tk.GetRelation(), tk.GetUser(), int32(operation),
+	)
+
+	return err
+}
+
+func conditionName(tk *openfgapb.TupleKey) interface{} {
+	if cond := tk.GetCondition(); cond != nil {
+		return cond.GetName()
+	}
+
+	return nil
+}
+
+func conditionContext(tk *openfgapb.TupleKey) interface{} {
+	cond := tk.GetCondition()
+	if cond == nil || cond.GetContext() == nil {
+		return nil
+	}
+
+	b, err := protojson.Marshal(cond.GetContext())
+	if err != nil {
+		return nil
+	}
+
+	return b
+}
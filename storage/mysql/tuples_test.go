@@ -0,0 +1,36 @@
+package mysql
+
+import (
+	"context"
+	"testing"
+
+	"github.com/openfga/openfga/pkg/storage"
+	openfgapb "go.buf.build/openfga/go/openfga/api/openfga/v1"
+)
+
+func TestUserFilterValue(t *testing.T) {
+	tests := []struct {
+		name string
+		u    *openfgapb.ObjectRelation
+		want string
+	}{
+		{"concrete object, no relation", &openfgapb.ObjectRelation{Object: "user:anne"}, "user:anne"},
+		{"userset, relation set", &openfgapb.ObjectRelation{Object: "group:eng", Relation: "member"}, "group:eng#member"},
+	}
+
+	for _, test := range tests {
+		if got := userFilterValue(test.u); got != test.want {
+			t.Errorf("%s: userFilterValue() = %q, want %q", test.name, got, test.want)
+		}
+	}
+}
+
+func TestEmptyTupleIteratorIsImmediatelyDone(t *testing.T) {
+	var it storage.TupleIterator = emptyTupleIterator{}
+
+	if _, err := it.Next(context.Background()); err != storage.ErrIteratorDone {
+		t.Errorf("Next() error = %v, want storage.ErrIteratorDone", err)
+	}
+
+	it.Stop() // must not panic
+}
@@ -0,0 +1,11 @@
+package mysql
+
+import (
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func timestampProto(t time.Time) *timestamppb.Timestamp {
+	return timestamppb.New(t)
+}